@@ -6,19 +6,84 @@ import (
 	zeerr "github.com/amanbolat/zederr/zeerr"
 	zei18n "github.com/amanbolat/zederr/zei18n"
 	pkgcodes "google.golang.org/grpc/codes"
+	fs "io/fs"
+	slices "slices"
+	atomic "sync/atomic"
 	time "time"
 )
 
 var defaultLocale = "en"
 
-var localizer = func() zeerr.Localizer {
-	l, err := zei18n.NewLocalizer(defaultLocale, ErrorMessages())
+var errorDefinitions = []zei18n.ErrorDefinition{
+	{
+		ID: "account_locked",
+		Arguments: []zei18n.ArgumentDefinition{
+			{Name: "user_id"},
+			{Name: "failed_attempts"},
+			{Name: "unlock_time", Format: "datetime"},
+		},
+		PluralArgument: "failed_attempts",
+	},
+}
+
+// localizerState holds the localizer used by the error constructors
+// and the error occurred while creating it.
+type localizerState struct {
+	localizer zeerr.Localizer
+	err       error
+}
+
+// localizer is replaced atomically by UseTranslationOverrides.
+var localizer atomic.Pointer[localizerState]
+
+func init() {
+	l, err := NewLocalizer()
+	localizer.Store(&localizerState{localizer: l, err: err})
+}
+
+// NewLocalizer creates a localizer with the embedded translations.
+// Use zei18n.WithValidation to validate the translations against the specification.
+func NewLocalizer(opts ...zei18n.Option) (zeerr.Localizer, error) {
+	opts = append([]zei18n.Option{zei18n.WithErrorDefinitions(errorDefinitions...)}, opts...)
+
+	return zei18n.NewLocalizer(defaultLocale, ErrorMessages(), opts...)
+}
+
+// ErrorDefinitions returns the definitions of the errors declared in the specification.
+// They can be passed to zei18n.WithErrorDefinitions, e.g. to register ErrorMessages in zei18n.CompositeLocalizer.
+func ErrorDefinitions() []zei18n.ErrorDefinition {
+	return slices.Clone(errorDefinitions)
+}
+
+// LocalizerErr returns the error occurred while creating the localizer with the embedded translations.
+// If it's not nil, the errors are created with their IDs as the messages.
+func LocalizerErr() error {
+	return localizer.Load().err
+}
+
+// Localizer returns the localizer used by the error constructors.
+// It also localizes the descriptions of the errors and their arguments, see zeerr.DescriptionLocalizer.
+// It's nil if the embedded translations are invalid, see LocalizerErr.
+func Localizer() zeerr.Localizer {
+	return localizer.Load().localizer
+}
+
+// UseTranslationOverrides replaces the localizer used by the error constructors
+// with the one that overrides the embedded translations with the files from the file system.
+// The returned localizer can be used to reload the overrides.
+// The errors created before the call keep the previous localizer.
+func UseTranslationOverrides(overrides fs.FS, opts ...zei18n.Option) (*zei18n.OverrideLocalizer, error) {
+	opts = append([]zei18n.Option{zei18n.WithErrorDefinitions(errorDefinitions...)}, opts...)
+
+	l, err := zei18n.NewOverrideLocalizer(defaultLocale, ErrorMessages(), overrides, opts...)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	return l
-}()
+	localizer.Store(&localizerState{localizer: l, err: nil})
+
+	return l, nil
+}
 
 // NewAccountLocked creates a new `account_locked` error.
 //
@@ -26,7 +91,7 @@ var localizer = func() zeerr.Localizer {
 func NewAccountLocked(ctx context.Context, user_id string, failed_attempts int, unlock_time time.Time) *zeerr.Error {
 	return zeerr.NewError(
 		ctx,
-		localizer,
+		Localizer(),
 		"account_locked",
 		401,
 		pkgcodes.Canceled,
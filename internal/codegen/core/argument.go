@@ -9,14 +9,24 @@ import (
 
 var argumentNameRegex = regexp.MustCompile("^(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?$")
 
+// argumentFormats lists the formats allowed for each argument type.
+// An empty format means the default one.
+var argumentFormats = map[ArgumentType]map[string]struct{}{
+	ArgumentTypeTimestamp: {"date": {}, "time": {}, "datetime": {}, "relative": {}},
+	ArgumentTypeInt:       {"number": {}, "raw": {}},
+	ArgumentTypeFloat:     {"number": {}, "raw": {}},
+}
+
 // Argument represents an argument used in the error messages.
 type Argument struct {
 	name        string
 	description string
 	typ         ArgumentType
+	// format is the format of the argument value in localized messages.
+	format string
 }
 
-func NewArgument(name, description, typ string) (Argument, error) {
+func NewArgument(name, description, typ, format string) (Argument, error) {
 	name = strings.TrimSpace(name)
 
 	if name == "" {
@@ -46,10 +56,19 @@ func NewArgument(name, description, typ string) (Argument, error) {
 		return Argument{}, err
 	}
 
+	format = strings.TrimSpace(format)
+
+	if format != "" {
+		if _, ok := argumentFormats[argTyp][format]; !ok {
+			return Argument{}, fmt.Errorf("argument %s of type %s does not support format %s", name, argTyp, format)
+		}
+	}
+
 	return Argument{
 		name:        name,
 		description: description,
 		typ:         argTyp,
+		format:      format,
 	}, nil
 }
 
@@ -64,3 +83,7 @@ func (a Argument) Description() string {
 func (a Argument) Typ() ArgumentType {
 	return a.typ
 }
+
+func (a Argument) Format() string {
+	return a.format
+}
@@ -0,0 +1,52 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/amanbolat/zederr/internal/codegen/core"
+)
+
+func TestNewArgument_Format(t *testing.T) {
+	tests := []struct {
+		name           string
+		typ            string
+		format         string
+		expectedFormat string
+		wantErr        bool
+	}{
+		{name: "timestamp default", typ: "timestamp", format: "", expectedFormat: ""},
+		{name: "timestamp date", typ: "timestamp", format: "date", expectedFormat: "date"},
+		{name: "timestamp time", typ: "timestamp", format: "time", expectedFormat: "time"},
+		{name: "timestamp datetime", typ: "timestamp", format: "datetime", expectedFormat: "datetime"},
+		{name: "timestamp relative", typ: "timestamp", format: "relative", expectedFormat: "relative"},
+		{name: "format is trimmed", typ: "timestamp", format: " date ", expectedFormat: "date"},
+		{name: "int number", typ: "int", format: "number", expectedFormat: "number"},
+		{name: "int raw", typ: "int", format: "raw", expectedFormat: "raw"},
+		{name: "float number", typ: "float", format: "number", expectedFormat: "number"},
+		{name: "float raw", typ: "float", format: "raw", expectedFormat: "raw"},
+		{name: "string default", typ: "string", format: "", expectedFormat: ""},
+		{name: "int with timestamp format", typ: "int", format: "date", wantErr: true},
+		{name: "timestamp with number format", typ: "timestamp", format: "number", wantErr: true},
+		{name: "string with format", typ: "string", format: "raw", wantErr: true},
+		{name: "bool with format", typ: "bool", format: "number", wantErr: true},
+		{name: "unknown format", typ: "timestamp", format: "iso8601", wantErr: true},
+		{name: "unknown type", typ: "duration", format: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arg, err := core.NewArgument("value", "The value.", tt.typ, tt.format)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFormat, arg.Format())
+		})
+	}
+}
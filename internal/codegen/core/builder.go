@@ -48,6 +48,7 @@ func (b *ErrorBuilder) NewError(
 	description string,
 	isDeprecated bool,
 	arguments []Argument,
+	pluralArgument string,
 	localization Localization,
 ) (Error, error) {
 	id = strings.TrimSpace(id)
@@ -114,6 +115,11 @@ func (b *ErrorBuilder) NewError(
 		argumentsMap[arg.Name()] = struct{}{}
 	}
 
+	err := validatePluralArgument(pluralArgument, arguments, localization)
+	if err != nil {
+		return Error{}, err
+	}
+
 	for argName := range localization.Arguments() {
 		if _, ok := argumentsMap[argName]; !ok {
 			return Error{}, fmt.Errorf("localization has argument %s that is not present in the error arguments", argName)
@@ -125,7 +131,7 @@ func (b *ErrorBuilder) NewError(
 		Arguments: argumentsMap,
 	})
 
-	err := templateValidator.Validate(message)
+	err = templateValidator.Validate(message)
 	if err != nil {
 		return Error{}, fmt.Errorf("public message is not a valid template; %w", err)
 	}
@@ -137,6 +143,15 @@ func (b *ErrorBuilder) NewError(
 		}
 	}
 
+	for lang, forms := range localization.PluralMessage() {
+		for category, msg := range forms {
+			err := templateValidator.Validate(msg)
+			if err != nil {
+				return Error{}, fmt.Errorf("public message plural form %s for %s language is not a valid template; %w", category, lang, err)
+			}
+		}
+	}
+
 	for argName, translations := range localization.Arguments() {
 		for lang, msg := range translations {
 			err := templateValidator.Validate(msg)
@@ -166,13 +181,40 @@ func (b *ErrorBuilder) NewError(
 	}
 
 	return Error{
-		id:           id,
-		grpcCode:     grpcCode,
-		httpCode:     httpCode,
-		description:  description,
-		message:      message,
-		isDeprecated: isDeprecated,
-		localization: localization,
-		arguments:    arguments,
+		id:             id,
+		grpcCode:       grpcCode,
+		httpCode:       httpCode,
+		description:    description,
+		message:        message,
+		isDeprecated:   isDeprecated,
+		localization:   localization,
+		arguments:      arguments,
+		pluralArgument: pluralArgument,
 	}, nil
 }
+
+// validatePluralArgument checks that the plural argument is declared with int type,
+// and that it's provided if the message has plural forms.
+func validatePluralArgument(pluralArgument string, arguments []Argument, localization Localization) error {
+	if pluralArgument == "" {
+		if len(localization.PluralMessage()) > 0 {
+			return fmt.Errorf("public message has plural forms, but plural argument is not set")
+		}
+
+		return nil
+	}
+
+	for _, arg := range arguments {
+		if arg.Name() != pluralArgument {
+			continue
+		}
+
+		if arg.Typ() != ArgumentTypeInt {
+			return fmt.Errorf("plural argument %s should be of type %s; got %s", pluralArgument, ArgumentTypeInt, arg.Typ())
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("plural argument %s is not present in the error arguments", pluralArgument)
+}
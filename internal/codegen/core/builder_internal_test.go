@@ -0,0 +1,54 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePluralArgument(t *testing.T) {
+	newArgument := func(name, typ string) Argument {
+		arg, err := NewArgument(name, name, typ, "")
+		require.NoError(t, err)
+
+		return arg
+	}
+
+	plural := NewLocalization()
+	require.NoError(t, plural.AddPluralMessageTranslation("en", map[string]string{
+		"one":   "{{.attempts}} attempt",
+		"other": "{{.attempts}} attempts",
+	}))
+
+	arguments := []Argument{
+		newArgument("attempts", "int"),
+		newArgument("ratio", "float"),
+	}
+
+	tests := []struct {
+		name           string
+		pluralArgument string
+		localization   Localization
+		wantErr        bool
+	}{
+		{name: "int argument", pluralArgument: "attempts", localization: plural},
+		{name: "no plural forms and no argument", pluralArgument: "", localization: NewLocalization()},
+		{name: "non-int argument", pluralArgument: "ratio", localization: plural, wantErr: true},
+		{name: "unknown argument", pluralArgument: "count", localization: plural, wantErr: true},
+		{name: "plural forms without plural argument", pluralArgument: "", localization: plural, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePluralArgument(tt.pluralArgument, arguments, tt.localization)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -13,6 +13,8 @@ type Error struct {
 	isDeprecated bool
 	localization Localization
 	arguments    []Argument
+	// pluralArgument is the name of the argument used to choose the plural form of the message.
+	pluralArgument string
 }
 
 func (e Error) ID() string {
@@ -49,3 +51,7 @@ func (e Error) Arguments() []Argument {
 
 	return arr
 }
+
+func (e Error) PluralArgument() string {
+	return e.pluralArgument
+}
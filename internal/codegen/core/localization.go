@@ -9,17 +9,40 @@ import (
 	"golang.org/x/text/language"
 )
 
+// CLDR plural categories.
+const (
+	PluralZero  = "zero"
+	PluralOne   = "one"
+	PluralTwo   = "two"
+	PluralFew   = "few"
+	PluralMany  = "many"
+	PluralOther = "other"
+)
+
+var pluralCategories = map[string]struct{}{
+	PluralZero:  {},
+	PluralOne:   {},
+	PluralTwo:   {},
+	PluralFew:   {},
+	PluralMany:  {},
+	PluralOther: {},
+}
+
 type Localization struct {
 	description map[language.Tag]string
 	arguments   map[string]map[language.Tag]string
 	message     map[language.Tag]string
+	// pluralMessage holds the plural forms of the message, including the `other` form,
+	// for the languages that declare more than one form.
+	pluralMessage map[language.Tag]map[string]string
 }
 
 func NewLocalization() Localization {
 	return Localization{
-		description: map[language.Tag]string{},
-		arguments:   map[string]map[language.Tag]string{},
-		message:     map[language.Tag]string{},
+		description:   map[language.Tag]string{},
+		arguments:     map[string]map[language.Tag]string{},
+		message:       map[language.Tag]string{},
+		pluralMessage: map[language.Tag]map[string]string{},
 	}
 }
 
@@ -74,6 +97,18 @@ func (l Localization) Message() map[language.Tag]string {
 	return m
 }
 
+// PluralMessage returns the plural forms of the message for the languages that declare more than one form.
+func (l Localization) PluralMessage() map[language.Tag]map[string]string {
+	m := map[language.Tag]map[string]string{}
+
+	for tag, forms := range l.pluralMessage {
+		m[tag] = map[string]string{}
+		maps.Copy(m[tag], forms)
+	}
+
+	return m
+}
+
 func (l *Localization) AddDescriptionTranslation(lang string, val string) error {
 	tag, err := language.Parse(lang)
 	if err != nil {
@@ -128,3 +163,35 @@ func (l *Localization) AddMessageTranslation(lang string, val string) error {
 
 	return nil
 }
+
+// AddPluralMessageTranslation adds the message translation with plural forms.
+// The keys are CLDR plural categories, and the `other` form is required.
+func (l *Localization) AddPluralMessageTranslation(lang string, forms map[string]string) error {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := forms[PluralOther]; !ok {
+		return fmt.Errorf("public message for %s language has no `%s` plural form", lang, PluralOther)
+	}
+
+	for category, val := range forms {
+		if _, ok := pluralCategories[category]; !ok {
+			return fmt.Errorf("public message for %s language has unknown plural category %s", lang, category)
+		}
+
+		if !utf8.ValidString(val) {
+			return fmt.Errorf("public message is not a valid UTF-8 string; got %s", val)
+		}
+	}
+
+	l.message[tag] = forms[PluralOther]
+
+	if len(forms) > 1 {
+		l.pluralMessage[tag] = map[string]string{}
+		maps.Copy(l.pluralMessage[tag], forms)
+	}
+
+	return nil
+}
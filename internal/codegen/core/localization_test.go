@@ -0,0 +1,71 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/amanbolat/zederr/internal/codegen/core"
+)
+
+func TestLocalization_AddPluralMessageTranslation(t *testing.T) {
+	tests := []struct {
+		name           string
+		lang           string
+		forms          map[string]string
+		expectedPlural map[string]string
+		wantErr        bool
+	}{
+		{
+			name:           "plural forms",
+			lang:           "en",
+			forms:          map[string]string{"one": "{{.n}} attempt", "other": "{{.n}} attempts"},
+			expectedPlural: map[string]string{"one": "{{.n}} attempt", "other": "{{.n}} attempts"},
+		},
+		{
+			name:           "only other form is not plural",
+			lang:           "en",
+			forms:          map[string]string{"other": "{{.n}} attempts"},
+			expectedPlural: nil,
+		},
+		{
+			name:    "missing other",
+			lang:    "en",
+			forms:   map[string]string{"one": "{{.n}} attempt"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown category",
+			lang:    "en",
+			forms:   map[string]string{"several": "{{.n}} attempts", "other": "{{.n}} attempts"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid language",
+			lang:    "not a language",
+			forms:   map[string]string{"other": "{{.n}} attempts"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := core.NewLocalization()
+
+			err := l.AddPluralMessageTranslation(tt.lang, tt.forms)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			tag := language.MustParse(tt.lang)
+			assert.Equal(t, tt.forms["other"], l.Message()[tag])
+			assert.Equal(t, tt.expectedPlural, l.PluralMessage()[tag])
+		})
+	}
+}
@@ -20,6 +20,7 @@ type Argument struct {
 	Name        string `yaml:"name"`
 	Description string `yaml:"description"`
 	Type        string `yaml:"type"`
+	Format      string `yaml:"format"`
 }
 
 type Arguments []Argument
@@ -71,6 +72,65 @@ func (t *Translations) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// Message is a message template with optional plural forms.
+// It can be declared either as a string, which is the `other` plural form,
+// or as a mapping of CLDR plural categories (zero, one, two, few, many, other) to templates.
+type Message map[string]string
+
+func (m *Message) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		var other string
+		if err := value.Decode(&other); err != nil {
+			return err
+		}
+
+		*m = Message{"other": other}
+
+		return nil
+	case yaml.MappingNode:
+		forms := map[string]string{}
+		if err := value.Decode(&forms); err != nil {
+			return fmt.Errorf("failed to decode message plural forms: %w", err)
+		}
+
+		*m = forms
+
+		return nil
+	case yaml.DocumentNode, yaml.SequenceNode, yaml.AliasNode:
+		fallthrough
+	default:
+		return fmt.Errorf("`message` should be of type yaml.ScalarNode or yaml.MappingNode, but got %v", value.Kind)
+	}
+}
+
+type MessageTranslation struct {
+	Lang  string
+	Value Message
+}
+
+type MessageTranslations []MessageTranslation
+
+func (t *MessageTranslations) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.MappingNode {
+		return fmt.Errorf("`message` translations should be of type yaml.MappingNode, but got %v", value.Kind)
+	}
+
+	*t = make([]MessageTranslation, len(value.Content)/2)
+	for i := 0; i < len(value.Content); i += 2 {
+		entry := &(*t)[i/2]
+		if err := value.Content[i+1].Decode(&entry.Value); err != nil {
+			return err
+		}
+
+		if err := value.Content[i].Decode(&entry.Lang); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type LocalizationArgument struct {
 	Name        string       `yaml:"name"`
 	Description Translations `yaml:"description"`
@@ -101,20 +161,21 @@ func (a *LocalizationArguments) UnmarshalYAML(value *yaml.Node) error {
 type Localization struct {
 	Arguments   LocalizationArguments `yaml:"arguments"`
 	Description Translations          `yaml:"description"`
-	Message     Translations          `yaml:"message"`
+	Message     MessageTranslations   `yaml:"message"`
 }
 
 // ErrorEntry represents a single error entry in the error codes file.
 // It is used only for unmarshalling from the source file.
 type ErrorEntry struct {
-	Code         string        `yaml:"code"`
-	GRPCCode     codes.Code    `yaml:"grpc_code"`
-	HTTPCode     int           `yaml:"http_code"`
-	Description  string        `yaml:"description"`
-	IsDeprecated bool          `yaml:"is_deprecated"`
-	Arguments    Arguments     `yaml:"arguments"`
-	Message      string        `yaml:"message"`
-	Localization *Localization `yaml:"localization"`
+	Code           string        `yaml:"code"`
+	GRPCCode       codes.Code    `yaml:"grpc_code"`
+	HTTPCode       int           `yaml:"http_code"`
+	Description    string        `yaml:"description"`
+	IsDeprecated   bool          `yaml:"is_deprecated"`
+	Arguments      Arguments     `yaml:"arguments"`
+	PluralArgument string        `yaml:"plural_argument"`
+	Message        Message       `yaml:"message"`
+	Localization   *Localization `yaml:"localization"`
 }
 
 // ErrorEntries is used to customize YAML unmarshalling of ErrorEntry.
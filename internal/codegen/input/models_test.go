@@ -0,0 +1,62 @@
+package input_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/amanbolat/zederr/internal/codegen/input"
+)
+
+func TestMessage_UnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name     string
+		yaml     string
+		expected input.Message
+		wantErr  bool
+	}{
+		{
+			name:     "scalar is the other form",
+			yaml:     `"Account is locked."`,
+			expected: input.Message{"other": "Account is locked."},
+		},
+		{
+			name:     "plural forms",
+			yaml:     "one: \"{{ .count }} attempt\"\nother: \"{{ .count }} attempts\"",
+			expected: input.Message{"one": "{{ .count }} attempt", "other": "{{ .count }} attempts"},
+		},
+		{
+			name:     "plural forms without other are decoded as is",
+			yaml:     `one: "{{ .count }} attempt"`,
+			expected: input.Message{"one": "{{ .count }} attempt"},
+		},
+		{
+			name:    "sequence",
+			yaml:    `["Account is locked."]`,
+			wantErr: true,
+		},
+		{
+			name:    "nested mapping",
+			yaml:    `one: {text: "attempt"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var msg input.Message
+
+			err := yaml.Unmarshal([]byte(tt.yaml), &msg)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, msg)
+		})
+	}
+}
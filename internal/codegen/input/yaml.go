@@ -50,7 +50,7 @@ func (i *YAMLImporter) Import(src io.Reader) (core.Spec, error) {
 		var args []core.Argument
 
 		for _, rawArg := range entry.Arguments {
-			arg, err := core.NewArgument(rawArg.Name, rawArg.Description, rawArg.Type)
+			arg, err := core.NewArgument(rawArg.Name, rawArg.Description, rawArg.Type, rawArg.Format)
 			if err != nil {
 				return core.Spec{}, err
 			}
@@ -60,6 +60,15 @@ func (i *YAMLImporter) Import(src io.Reader) (core.Spec, error) {
 
 		localization := core.NewLocalization()
 
+		// Plural forms of the message in default locale are added as a translation,
+		// while the `other` form is passed to the builder as the message.
+		if len(entry.Message) > 1 {
+			err = localization.AddPluralMessageTranslation(yamlSpec.DefaultLocale, entry.Message)
+			if err != nil {
+				return core.Spec{}, err
+			}
+		}
+
 		if entry.Localization != nil {
 			for _, tr := range entry.Localization.Description {
 				err = localization.AddDescriptionTranslation(tr.Lang, tr.Value)
@@ -69,7 +78,7 @@ func (i *YAMLImporter) Import(src io.Reader) (core.Spec, error) {
 			}
 
 			for _, tr := range entry.Localization.Message {
-				err = localization.AddMessageTranslation(tr.Lang, tr.Value)
+				err = localization.AddPluralMessageTranslation(tr.Lang, tr.Value)
 				if err != nil {
 					return core.Spec{}, err
 				}
@@ -87,12 +96,13 @@ func (i *YAMLImporter) Import(src io.Reader) (core.Spec, error) {
 
 		zedErr, err := errBuilder.NewError(
 			entry.Code,
-			entry.Message,
+			entry.Message[core.PluralOther],
 			entry.GRPCCode,
 			entry.HTTPCode,
 			entry.Description,
 			entry.IsDeprecated,
 			args,
+			entry.PluralArgument,
 			localization,
 		)
 		if err != nil {
@@ -7,6 +7,7 @@ import (
 	"go/format"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"text/template"
 
@@ -78,15 +79,32 @@ type goErrorLocalesTemplateData struct {
 }
 
 // localeEntry represents a single translation entry in a locale file.
+// Plural forms other than `other` are written only for messages that declare them.
 //
 // Example toml representation:
 //
 //	["acme.com/auth/unauthorized"] <-- provided by map key
 //	other = "Please sign in" <-- localeEntry
 type localeEntry struct {
+	Zero  string `toml:"zero,omitempty"`
+	One   string `toml:"one,omitempty"`
+	Two   string `toml:"two,omitempty"`
+	Few   string `toml:"few,omitempty"`
+	Many  string `toml:"many,omitempty"`
 	Other string `toml:"other"`
 }
 
+func newPluralLocaleEntry(forms map[string]string) localeEntry {
+	return localeEntry{
+		Zero:  forms[core.PluralZero],
+		One:   forms[core.PluralOne],
+		Two:   forms[core.PluralTwo],
+		Few:   forms[core.PluralFew],
+		Many:  forms[core.PluralMany],
+		Other: forms[core.PluralOther],
+	}
+}
+
 type GoExporter struct{}
 
 func NewGoExporter() *GoExporter {
@@ -138,6 +156,11 @@ func (e *GoExporter) renderLocalesEmbed(cfg core.ExportGo, spec core.Spec) error
 		})
 	}
 
+	// Sort locales to keep the generated code stable.
+	slices.SortFunc(localesTemplateData, func(a, b localeTemplateData) int {
+		return strings.Compare(a.FileName, b.FileName)
+	})
+
 	tmpl := template.New("")
 	tmpl.Funcs(template.FuncMap{
 		"toUpper": strings.ToUpper,
@@ -189,6 +212,9 @@ func (e *GoExporter) renderErrors(cfg core.ExportGo, spec core.Spec) error {
 
 	imports := []string{
 		`context "context"`,
+		`fs "io/fs"`,
+		`slices "slices"`,
+		`atomic "sync/atomic"`,
 		`zeerr "github.com/amanbolat/zederr/zeerr"`,
 		`zei18n "github.com/amanbolat/zederr/zei18n"`,
 		`pkgcodes "google.golang.org/grpc/codes"`,
@@ -242,6 +268,10 @@ func (e *GoExporter) renderLocales(cfg core.ExportGo, spec core.Spec) error {
 			}
 		}
 
+		for lang, forms := range coreErr.Localization().PluralMessage() {
+			entryMap[lang][coreErr.ID()+"_message"] = newPluralLocaleEntry(forms)
+		}
+
 		for argName, translations := range coreErr.Localization().Arguments() {
 			for lang, translation := range translations {
 				entryMap[lang][coreErr.ID()+"_argument_"+argName] = localeEntry{
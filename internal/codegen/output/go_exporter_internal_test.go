@@ -0,0 +1,51 @@
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPluralLocaleEntry(t *testing.T) {
+	tests := []struct {
+		name     string
+		forms    map[string]string
+		expected localeEntry
+	}{
+		{
+			name:     "other only",
+			forms:    map[string]string{"other": "attempts"},
+			expected: localeEntry{Zero: "", One: "", Two: "", Few: "", Many: "", Other: "attempts"},
+		},
+		{
+			name: "all categories",
+			forms: map[string]string{
+				"zero":  "no attempts",
+				"one":   "attempt",
+				"two":   "two attempts",
+				"few":   "few attempts",
+				"many":  "many attempts",
+				"other": "attempts",
+			},
+			expected: localeEntry{
+				Zero:  "no attempts",
+				One:   "attempt",
+				Two:   "two attempts",
+				Few:   "few attempts",
+				Many:  "many attempts",
+				Other: "attempts",
+			},
+		},
+		{
+			name:     "unknown categories are dropped",
+			forms:    map[string]string{"several": "several attempts", "other": "attempts"},
+			expected: localeEntry{Zero: "", One: "", Two: "", Few: "", Many: "", Other: "attempts"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, newPluralLocaleEntry(tt.forms))
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package zeerr
+
+import (
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+)
+
+const truncatedErrorID = "truncated"
+
+// Codes are the HTTP and gRPC codes of an error.
+type Codes struct {
+	HTTPCode int
+	GRPCCode codes.Code
+}
+
+// CodePolicy derives the codes of the parent error from the codes of the collected errors,
+// including the ones dropped over the limit. The codes are counted by the distinct pairs.
+type CodePolicy func(parent *Error, causeCodes map[Codes]int) (httpCode int, grpcCode codes.Code)
+
+// UniformCodePolicy gives the parent the codes shared by all the causes,
+// e.g. 400 and codes.InvalidArgument if all the causes are invalid arguments.
+// The parent keeps its own code if the causes have different ones.
+func UniformCodePolicy(parent *Error, causeCodes map[Codes]int) (int, codes.Code) {
+	httpCode, grpcCode := parent.httpCode, parent.grpcCode
+
+	var first *Codes
+
+	uniformHTTP, uniformGRPC := true, true
+
+	for c := range causeCodes {
+		if first == nil {
+			first = &c
+
+			continue
+		}
+
+		uniformHTTP = uniformHTTP && c.HTTPCode == first.HTTPCode
+		uniformGRPC = uniformGRPC && c.GRPCCode == first.GRPCCode
+	}
+
+	if first == nil {
+		return httpCode, grpcCode
+	}
+
+	if uniformHTTP {
+		httpCode = first.HTTPCode
+	}
+
+	if uniformGRPC {
+		grpcCode = first.GRPCCode
+	}
+
+	return httpCode, grpcCode
+}
+
+// TruncatedErrorFunc creates the error added as the last cause when the causes over the limit are dropped.
+type TruncatedErrorFunc func(parent *Error, omitted int) *Error
+
+func defaultTruncatedError(parent *Error, omitted int) *Error {
+	return RestoreError(
+		truncatedErrorID,
+		parent.httpCode,
+		parent.grpcCode,
+		map[string]any{"omitted": omitted},
+		fmt.Sprintf("%d more errors are omitted", omitted),
+		nil,
+	)
+}
+
+// CollectorOption configures the Collector.
+type CollectorOption func(*Collector)
+
+// WithMaxCauses limits the number of the collected causes, the ones over the limit are dropped
+// and only their codes are kept for the code policy.
+// The error created by truncatedErrorFunc is added as the last cause if any cause is dropped.
+// If truncatedErrorFunc is nil, an error with `truncated` ID and the parent codes is used.
+func WithMaxCauses(maxCauses int, truncatedErrorFunc TruncatedErrorFunc) CollectorOption {
+	return func(c *Collector) {
+		if truncatedErrorFunc == nil {
+			truncatedErrorFunc = defaultTruncatedError
+		}
+
+		c.maxCauses = maxCauses
+		c.truncatedErrorFunc = truncatedErrorFunc
+	}
+}
+
+// WithCodePolicy sets the policy used to derive the codes of the parent error from the causes.
+// By default, the parent keeps its own codes.
+func WithCodePolicy(policy CodePolicy) CollectorOption {
+	return func(c *Collector) {
+		c.codePolicy = policy
+	}
+}
+
+// Collector accumulates the errors, e.g. from the batch validation, to return them as the causes of a parent error.
+// It is safe for concurrent use.
+type Collector struct {
+	mu      sync.Mutex
+	causes  []*Error
+	omitted int
+	// causeCodes counts the codes of all the collected errors, including the dropped ones.
+	causeCodes         map[Codes]int
+	maxCauses          int
+	truncatedErrorFunc TruncatedErrorFunc
+	codePolicy         CodePolicy
+}
+
+// NewCollector creates a new Collector.
+func NewCollector(opts ...CollectorOption) *Collector {
+	c := &Collector{
+		causes:             nil,
+		omitted:            0,
+		causeCodes:         map[Codes]int{},
+		maxCauses:          0,
+		truncatedErrorFunc: nil,
+		codePolicy:         nil,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Add collects the errors. Nil errors are ignored.
+func (c *Collector) Add(errs ...*Error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		c.causeCodes[Codes{HTTPCode: err.httpCode, GRPCCode: err.grpcCode}]++
+
+		if c.maxCauses > 0 && len(c.causes) >= c.maxCauses {
+			c.omitted++
+
+			continue
+		}
+
+		c.causes = append(c.causes, err)
+	}
+}
+
+// AddWithTarget sets the target of the error and collects it. Nil error is ignored.
+// The error is modified the same way as by Error.WithTarget, pass a new error for each call.
+func (c *Collector) AddWithTarget(target string, err *Error) {
+	if err == nil {
+		return
+	}
+
+	c.Add(err.WithTarget(target))
+}
+
+// Len returns the number of the collected errors, including the dropped ones.
+func (c *Collector) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.causes) + c.omitted
+}
+
+// Finish adds the collected errors as the causes of the parent error and returns it.
+// It returns nil if no errors are collected.
+// The collector is reset, so the errors collected after the call are added to the next parent.
+// A nil parent is ignored: nil is returned and the collected errors are kept.
+func (c *Collector) Finish(parent *Error) *Error {
+	if parent == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	causes, omitted, causeCodes := c.causes, c.omitted, c.causeCodes
+	c.causes, c.omitted, c.causeCodes = nil, 0, map[Codes]int{}
+	c.mu.Unlock()
+
+	if len(causes) == 0 {
+		return nil
+	}
+
+	if c.codePolicy != nil {
+		parent.httpCode, parent.grpcCode = c.codePolicy(parent, causeCodes)
+	}
+
+	parent.WithCauses(causes...)
+
+	if omitted > 0 {
+		parent.WithCauses(c.truncatedErrorFunc(parent, omitted))
+	}
+
+	return parent
+}
@@ -0,0 +1,84 @@
+package zeerr_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+func TestCollector(t *testing.T) {
+	collector := zeerr.NewCollector(
+		zeerr.WithMaxCauses(3, nil),
+		zeerr.WithCodePolicy(zeerr.UniformCodePolicy),
+	)
+
+	assert.Nil(t, collector.Finish(zeerr.RestoreError("invalid_form", 422, codes.Unknown, nil, "form is invalid", nil)))
+
+	var wg sync.WaitGroup
+
+	for i := range 5 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			collector.AddWithTarget(
+				fmt.Sprintf("/items/%d", i),
+				zeerr.RestoreError("invalid_item", 400, codes.InvalidArgument, nil, "item is invalid", nil),
+			)
+		}()
+	}
+
+	collector.Add(nil)
+	wg.Wait()
+
+	assert.Equal(t, 5, collector.Len())
+
+	err := collector.Finish(zeerr.RestoreError("invalid_form", 422, codes.Unknown, nil, "form is invalid", nil))
+	require.NotNil(t, err)
+	assert.Equal(t, 400, err.HTTPCode())
+	assert.Equal(t, codes.InvalidArgument, err.GRPCCode())
+
+	causes := err.Causes()
+	require.Len(t, causes, 4)
+	assert.Contains(t, causes[0].Target(), "/items/")
+	assert.Equal(t, "truncated", causes[3].ID())
+	assert.Equal(t, "2 more errors are omitted", causes[3].Message())
+
+	assert.Equal(t, 0, collector.Len())
+	assert.Nil(t, collector.Finish(zeerr.RestoreError("invalid_form", 422, codes.Unknown, nil, "form is invalid", nil)))
+}
+
+func TestCollector_CodePolicyWithDroppedCauses(t *testing.T) {
+	collector := zeerr.NewCollector(
+		zeerr.WithMaxCauses(1, nil),
+		zeerr.WithCodePolicy(zeerr.UniformCodePolicy),
+	)
+
+	collector.Add(
+		zeerr.RestoreError("invalid_item", 400, codes.InvalidArgument, nil, "item is invalid", nil),
+		zeerr.RestoreError("internal", 500, codes.Internal, nil, "internal error", nil),
+	)
+
+	err := collector.Finish(zeerr.RestoreError("batch_failed", 422, codes.Unknown, nil, "batch failed", nil))
+	require.NotNil(t, err)
+	assert.Equal(t, 422, err.HTTPCode())
+	assert.Equal(t, codes.Unknown, err.GRPCCode())
+	require.Len(t, err.Causes(), 2)
+	assert.Equal(t, "invalid_item", err.Causes()[0].ID())
+	assert.Equal(t, "truncated", err.Causes()[1].ID())
+}
+
+func TestCollector_Finish_NilParent(t *testing.T) {
+	collector := zeerr.NewCollector()
+	collector.Add(zeerr.RestoreError("invalid_item", 400, codes.InvalidArgument, nil, "item is invalid", nil))
+
+	assert.Nil(t, collector.Finish(nil))
+	assert.Equal(t, 1, collector.Len())
+}
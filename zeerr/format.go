@@ -0,0 +1,134 @@
+package zeerr
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// LogValue implements slog.LogValuer.
+// The error is logged as a group with the ID, codes, message, target, arguments, metadata, internal error,
+// stack trace and causes. The metadata is logged regardless of the allow-list.
+func (e *Error) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("id", e.id),
+		slog.String("grpc_code", e.grpcCode.String()),
+		slog.Int("http_code", e.httpCode),
+		slog.String("message", e.Message()),
+	}
+
+	if e.target != "" {
+		attrs = append(attrs, slog.String("target", e.target))
+	}
+
+	if len(e.arguments) > 0 {
+		args := make([]slog.Attr, 0, len(e.arguments))
+		for _, k := range sortedKeys(e.arguments) {
+			args = append(args, slog.Any(k, e.arguments[k]))
+		}
+
+		attrs = append(attrs, slog.Attr{Key: "arguments", Value: slog.GroupValue(args...)})
+	}
+
+	if len(e.metadata) > 0 {
+		md := make([]slog.Attr, 0, len(e.metadata))
+		for _, k := range sortedKeys(e.metadata) {
+			md = append(md, slog.String(k, e.metadata[k]))
+		}
+
+		attrs = append(attrs, slog.Attr{Key: "metadata", Value: slog.GroupValue(md...)})
+	}
+
+	if e.internalErr != nil {
+		attrs = append(attrs, slog.String("internal_error", e.internalErr.Error()))
+	}
+
+	if len(e.stackTrace) > 0 {
+		attrs = append(attrs, slog.Any("stack_trace", e.stackTrace.Lines()))
+	}
+
+	if len(e.causes) > 0 {
+		causes := make([]slog.Attr, 0, len(e.causes))
+		for i, cause := range e.causes {
+			causes = append(causes, slog.Attr{Key: strconv.Itoa(i), Value: cause.LogValue()})
+		}
+
+		attrs = append(attrs, slog.Attr{Key: "causes", Value: slog.GroupValue(causes...)})
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// Format implements fmt.Formatter.
+// The `%+v` verb prints the whole tree of the error with the codes, targets, arguments, metadata,
+// internal errors and stack traces,
+// other verbs print the same as Error.
+func (e *Error) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		e.writeTree(s, 0)
+	case verb == 'q':
+		_, _ = io.WriteString(s, strconv.Quote(e.Error()))
+	default:
+		_, _ = io.WriteString(s, e.Error())
+	}
+}
+
+func (e *Error) writeTree(w io.Writer, depth int) {
+	indent := strings.Repeat("    ", depth)
+
+	_, _ = fmt.Fprintf(w, "%s%s (http: %d, grpc: %s): %s", indent, e.id, e.httpCode, e.grpcCode, e.Message())
+
+	if e.target != "" {
+		_, _ = fmt.Fprintf(w, "\n%s    target: %s", indent, e.target)
+	}
+
+	if len(e.arguments) > 0 {
+		args := make([]string, 0, len(e.arguments))
+		for _, k := range sortedKeys(e.arguments) {
+			args = append(args, fmt.Sprintf("%s=%v", k, e.arguments[k]))
+		}
+
+		_, _ = fmt.Fprintf(w, "\n%s    arguments: %s", indent, strings.Join(args, ", "))
+	}
+
+	if len(e.metadata) > 0 {
+		md := make([]string, 0, len(e.metadata))
+		for _, k := range sortedKeys(e.metadata) {
+			md = append(md, k+"="+e.metadata[k])
+		}
+
+		_, _ = fmt.Fprintf(w, "\n%s    metadata: %s", indent, strings.Join(md, ", "))
+	}
+
+	if e.internalErr != nil {
+		_, _ = fmt.Fprintf(w, "\n%s    internal error: %+v", indent, e.internalErr)
+	}
+
+	if len(e.stackTrace) > 0 {
+		_, _ = fmt.Fprintf(w, "\n%s    stack trace:", indent)
+
+		for _, line := range e.stackTrace.Lines() {
+			_, _ = fmt.Fprintf(w, "\n%s        %s", indent, line)
+		}
+	}
+
+	for _, cause := range e.causes {
+		_, _ = io.WriteString(w, "\n")
+		cause.writeTree(w, depth+1)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
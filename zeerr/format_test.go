@@ -0,0 +1,55 @@
+package zeerr_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+func TestError_Format(t *testing.T) {
+	err := zeerr.RestoreError("invalid_form", 400, codes.InvalidArgument, map[string]any{"form": "signup"}, "form is invalid", nil).
+		WithInternalError(errors.New("validation failed")).
+		WithCauses(
+			zeerr.RestoreError("invalid_field", 400, codes.InvalidArgument, map[string]any{"field": "email", "max": 64}, "field is invalid", nil),
+		)
+
+	assert.Equal(t, "form is invalid\n\tfield is invalid", fmt.Sprintf("%v", err))
+	assert.Equal(t, `invalid_form (http: 400, grpc: InvalidArgument): form is invalid
+    arguments: form=signup
+    internal error: validation failed
+    invalid_field (http: 400, grpc: InvalidArgument): field is invalid
+        arguments: field=email, max=64`, fmt.Sprintf("%+v", err))
+}
+
+func TestError_LogValue(t *testing.T) {
+	err := zeerr.RestoreError("invalid_form", 400, codes.InvalidArgument, map[string]any{"form": "signup"}, "form is invalid", nil).
+		WithInternalError(errors.New("validation failed")).
+		WithCauses(zeerr.RestoreError("invalid_field", 400, codes.InvalidArgument, nil, "field is invalid", nil))
+
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+
+			return a
+		},
+	}))
+	logger.Error("request failed", "error", err)
+
+	assert.Equal(t, `level=ERROR msg="request failed" error.id=invalid_form error.grpc_code=InvalidArgument `+
+		`error.http_code=400 error.message="form is invalid" error.arguments.form=signup `+
+		`error.internal_error="validation failed" error.causes.0.id=invalid_field error.causes.0.grpc_code=InvalidArgument `+
+		`error.causes.0.http_code=400 error.causes.0.message="field is invalid"`+"\n", buf.String())
+}
+
+// newTestError mimics a generated constructor.
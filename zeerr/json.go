@@ -0,0 +1,126 @@
+package zeerr
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// jsonError is the JSON representation of Error, see Error.MarshalJSON.
+type jsonError struct {
+	ID        string         `json:"id"`
+	HTTPCode  int            `json:"http_code"`
+	GRPCCode  uint32         `json:"grpc_code"`
+	Message   string         `json:"message"`
+	Arguments map[string]any `json:"arguments"`
+	// TimestampArguments lists the arguments encoded from time.Time,
+	// only those are decoded back to time.Time.
+	TimestampArguments []string          `json:"timestamp_arguments,omitempty"`
+	Target             string            `json:"target,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	Causes             []*jsonError      `json:"causes"`
+}
+
+func newJSONError(e *Error) *jsonError {
+	args := make(map[string]any, len(e.arguments))
+
+	var timestamps []string
+
+	for _, k := range sortedKeys(e.arguments) {
+		v := e.arguments[k]
+		if t, ok := v.(time.Time); ok {
+			v = t.Format(time.RFC3339Nano)
+			timestamps = append(timestamps, k)
+		}
+
+		args[k] = v
+	}
+
+	causes := make([]*jsonError, 0, len(e.causes))
+	for _, cause := range e.causes {
+		causes = append(causes, newJSONError(cause))
+	}
+
+	return &jsonError{
+		ID:                 e.id,
+		HTTPCode:           e.httpCode,
+		GRPCCode:           uint32(e.grpcCode),
+		Message:            e.Message(),
+		Arguments:          args,
+		TimestampArguments: timestamps,
+		Target:             e.target,
+		Metadata:           e.PublicMetadata(),
+		Causes:             causes,
+	}
+}
+
+func (j *jsonError) restore() *Error {
+	args := make(map[string]any, len(j.Arguments))
+	for k, v := range j.Arguments {
+		args[k] = v
+	}
+
+	for _, k := range j.TimestampArguments {
+		s, ok := args[k].(string)
+		if !ok {
+			continue
+		}
+
+		if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+			args[k] = t
+		}
+	}
+
+	causes := make([]*Error, 0, len(j.Causes))
+	for _, cause := range j.Causes {
+		if cause != nil {
+			causes = append(causes, cause.restore())
+		}
+	}
+
+	return RestoreError(j.ID, j.HTTPCode, codes.Code(j.GRPCCode), args, j.Message, causes).
+		WithTarget(j.Target).
+		WithMetadata(j.Metadata)
+}
+
+// MarshalJSON implements json.Marshaler interface.
+// Timestamp arguments are encoded as RFC 3339 strings and listed in `timestamp_arguments`.
+// The internal error is never included, and the metadata is filtered by the allow-list, see SetMetadataAllowList.
+// The `timestamp_arguments`, `target` and `metadata` fields are omitted if empty.
+//
+// Example:
+//
+//	{
+//	  "id": "account_locked",
+//	  "http_code": 401,
+//	  "grpc_code": 16,
+//	  "message": "Your account is locked.",
+//	  "arguments": {"unlock_time": "2024-06-26T00:36:06.33748+02:00"},
+//	  "timestamp_arguments": ["unlock_time"],
+//	  "target": "/password",
+//	  "metadata": {"request_id": "5b3a5e4c"},
+//	  "causes": []
+//	}
+func (e Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newJSONError(&e))
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// It accepts the format produced by MarshalJSON.
+// The error is restored the same way as RestoreError does,
+// the arguments listed in `timestamp_arguments` are decoded as time.Time,
+// the other ones are decoded as the values of encoding/json, e.g. numbers as float64.
+func (e *Error) UnmarshalJSON(data []byte) error {
+	var j jsonError
+
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal error: %w", err)
+	}
+
+	*e = *j.restore()
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package zeerr_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+func TestError_JSON(t *testing.T) {
+	unlockTime := time.Date(2024, 6, 26, 0, 36, 6, 337480000, time.FixedZone("CEST", 2*60*60))
+
+	err := zeerr.RestoreError("invalid_form", 400, codes.InvalidArgument, map[string]any{}, "form is invalid", nil).
+		WithCauses(
+			zeerr.RestoreError("account_locked", 401, codes.Unauthenticated, map[string]any{
+				"user_id":     "user_1",
+				"code":        "2024-01-01T00:00:00Z",
+				"unlock_time": unlockTime,
+			}, "account is locked", nil).WithInternalError(sql.ErrNoRows).WithTarget("/user"),
+		)
+
+	b, jsonErr := json.Marshal(err)
+	require.NoError(t, jsonErr)
+	assert.JSONEq(t, `{
+		"id": "invalid_form",
+		"http_code": 400,
+		"grpc_code": 3,
+		"message": "form is invalid",
+		"arguments": {},
+		"causes": [{
+			"id": "account_locked",
+			"http_code": 401,
+			"grpc_code": 16,
+			"message": "account is locked",
+			"arguments": {"user_id": "user_1", "code": "2024-01-01T00:00:00Z", "unlock_time": "2024-06-26T00:36:06.33748+02:00"},
+			"timestamp_arguments": ["unlock_time"],
+			"target": "/user",
+			"causes": []
+		}]
+	}`, string(b))
+
+	var decoded zeerr.Error
+	require.NoError(t, json.Unmarshal(b, &decoded))
+
+	assert.Equal(t, "invalid_form", decoded.ID())
+	assert.Equal(t, codes.InvalidArgument, decoded.GRPCCode())
+	require.Len(t, decoded.Causes(), 1)
+
+	cause := decoded.Causes()[0]
+	assert.Equal(t, "account_locked", cause.ID())
+	assert.Equal(t, 401, cause.HTTPCode())
+	assert.Equal(t, "account is locked", cause.Message())
+	assert.Nil(t, cause.InternalErr())
+	assert.Equal(t, "/user", cause.Target())
+	assert.Empty(t, decoded.Target())
+	assert.Equal(t, "user_1", cause.Arguments()["user_id"])
+	assert.Equal(t, "2024-01-01T00:00:00Z", cause.Arguments()["code"])
+
+	if decodedTime, ok := cause.Arguments()["unlock_time"].(time.Time); assert.True(t, ok) {
+		assert.True(t, unlockTime.Equal(decodedTime))
+	}
+}
@@ -2,14 +2,100 @@ package zeerr
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"golang.org/x/text/language"
 )
 
 type LocaleCtxKeyType struct{}
 
+type localesCtxKeyType struct{}
+
+type timeZoneCtxKeyType struct{}
+
+// ContextWithLocale stores the locale in the context.
 func ContextWithLocale(ctx context.Context, lang language.Tag) context.Context {
-	return context.WithValue(ctx, LocaleCtxKeyType{}, lang)
+	return ContextWithLocales(ctx, lang)
+}
+
+// ContextWithLocales stores the locales ordered by preference in the context.
+// The most preferred locale is also available with LocaleFromContext.
+func ContextWithLocales(ctx context.Context, langs ...language.Tag) context.Context {
+	if len(langs) == 0 {
+		return ctx
+	}
+
+	ctx = context.WithValue(ctx, LocaleCtxKeyType{}, langs[0])
+
+	return context.WithValue(ctx, localesCtxKeyType{}, langs)
+}
+
+// LocaleFromContext returns the most preferred locale stored in the context.
+func LocaleFromContext(ctx context.Context) (language.Tag, bool) {
+	lang, ok := ctx.Value(LocaleCtxKeyType{}).(language.Tag)
+
+	return lang, ok
+}
+
+// LocalesFromContext returns the locales ordered by preference stored in the context.
+func LocalesFromContext(ctx context.Context) []language.Tag {
+	lang, ok := LocaleFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	langs, ok := ctx.Value(localesCtxKeyType{}).([]language.Tag)
+	if !ok || len(langs) == 0 || langs[0] != lang {
+		return []language.Tag{lang}
+	}
+
+	return langs
+}
+
+// ContextWithTimeZone stores the time zone used to format timestamp arguments in the context.
+func ContextWithTimeZone(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, timeZoneCtxKeyType{}, loc)
+}
+
+// TimeZoneFromContext returns the time zone stored in the context with ContextWithTimeZone.
+func TimeZoneFromContext(ctx context.Context) (*time.Location, bool) {
+	loc, ok := ctx.Value(timeZoneCtxKeyType{}).(*time.Location)
+
+	return loc, ok && loc != nil
+}
+
+// ParseAcceptLanguage parses the value of `Accept-Language` header
+// and returns the locales ordered by preference. Invalid values are ignored.
+func ParseAcceptLanguage(s string) []language.Tag {
+	tags, _, err := language.ParseAcceptLanguage(s)
+	if err != nil {
+		return nil
+	}
+
+	return tags
+}
+
+// FormatAcceptLanguage formats the locales ordered by preference as a value of `Accept-Language` header.
+func FormatAcceptLanguage(langs ...language.Tag) string {
+	var sb strings.Builder
+
+	for i, lang := range langs {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		sb.WriteString(lang.String())
+
+		// The quality values decrease by 0.1 and are limited by 0.1.
+		if i > 0 {
+			q := 10 - min(i, 9)
+			sb.WriteString(";q=0.")
+			sb.WriteByte(byte('0' + q))
+		}
+	}
+
+	return sb.String()
 }
 
 // Localizer is responsible for localizing public and internal error messages.
@@ -17,3 +103,39 @@ type Localizer interface {
 	// LocalizeMessage localizes error's message.
 	LocalizeMessage(id string, lang language.Tag, args map[string]any) string
 }
+
+// LanguageMatcher can be implemented by Localizer to choose the closest supported locale
+// for the list of preferred locales.
+type LanguageMatcher interface {
+	// MatchLanguage returns the supported locale closest to the preferred ones.
+	MatchLanguage(prefs ...language.Tag) language.Tag
+}
+
+// DescriptionLocalizer can be implemented by Localizer to localize the descriptions
+// of the errors and their arguments declared in the specification.
+type DescriptionLocalizer interface {
+	// LocalizeDescription localizes error's description.
+	LocalizeDescription(id string, lang language.Tag) string
+	// LocalizeArgumentDescription localizes the description of error's argument.
+	LocalizeArgumentDescription(id, arg string, lang language.Tag) string
+}
+
+// MessageChecker can be implemented by Localizer to report whether it has the message of the error.
+// The errors it has no messages for keep their messages in Error.WithLocalizer.
+type MessageChecker interface {
+	// HasMessage reports whether the error's message can be localized.
+	HasMessage(id string) bool
+}
+
+// matchLanguage resolves the locale used to localize the message.
+func matchLanguage(localizer Localizer, prefs []language.Tag) language.Tag {
+	if matcher, ok := localizer.(LanguageMatcher); ok {
+		return matcher.MatchLanguage(prefs...)
+	}
+
+	if len(prefs) == 0 {
+		return language.Und
+	}
+
+	return prefs[0]
+}
@@ -0,0 +1,26 @@
+package zeerr_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+func TestContextWithLocales(t *testing.T) {
+	langs := zeerr.ParseAcceptLanguage("zh-Hans-CN, en-GB;q=0.8, fr;q=0.5")
+	ctx := zeerr.ContextWithLocales(context.Background(), langs...)
+
+	assert.Equal(t, langs, zeerr.LocalesFromContext(ctx))
+	assert.Equal(t, "zh-Hans-CN, en-GB;q=0.9, fr;q=0.8", zeerr.FormatAcceptLanguage(langs...))
+
+	lang, ok := zeerr.LocaleFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, language.MustParse("zh-Hans-CN"), lang)
+
+	ctx = zeerr.ContextWithLocale(ctx, language.German)
+	assert.Equal(t, []language.Tag{language.German}, zeerr.LocalesFromContext(ctx))
+}
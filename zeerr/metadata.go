@@ -0,0 +1,140 @@
+package zeerr
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// ContextEnricher returns the metadata attached to the errors created with the context,
+// e.g. the request ID or the trace ID.
+type ContextEnricher func(ctx context.Context) map[string]string
+
+// registeredEnricher is stored by pointer to be found on unregistration, as functions are not comparable.
+type registeredEnricher struct {
+	enricher ContextEnricher
+}
+
+var (
+	enrichersMu sync.Mutex
+	enrichers   atomic.Pointer[[]*registeredEnricher]
+
+	metadataAllowList atomic.Pointer[map[string]struct{}]
+)
+
+// RegisterContextEnricher registers the enricher run by NewError against its context.
+// The metadata returned by the enrichers registered later overrides the earlier ones.
+// The returned function unregisters the enricher, it's safe to call it more than once.
+func RegisterContextEnricher(enricher ContextEnricher) (unregister func()) {
+	entry := &registeredEnricher{enricher: enricher}
+
+	updateEnrichers(func(registered []*registeredEnricher) []*registeredEnricher {
+		return append(registered, entry)
+	})
+
+	return func() {
+		updateEnrichers(func(registered []*registeredEnricher) []*registeredEnricher {
+			return slices.DeleteFunc(registered, func(e *registeredEnricher) bool {
+				return e == entry
+			})
+		})
+	}
+}
+
+// updateEnrichers replaces the registered enrichers with the updated copy of them.
+func updateEnrichers(update func([]*registeredEnricher) []*registeredEnricher) {
+	enrichersMu.Lock()
+	defer enrichersMu.Unlock()
+
+	var registered []*registeredEnricher
+	if current := enrichers.Load(); current != nil {
+		registered = append(registered, *current...)
+	}
+
+	registered = update(registered)
+	enrichers.Store(&registered)
+}
+
+// SetMetadataAllowList sets the metadata keys that are sent by the encoders.
+// No metadata leaves the process by default.
+func SetMetadataAllowList(keys ...string) {
+	allowed := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		allowed[k] = struct{}{}
+	}
+
+	metadataAllowList.Store(&allowed)
+}
+
+// enrichMetadata runs the registered enrichers against the context.
+func enrichMetadata(ctx context.Context) map[string]string {
+	registered := enrichers.Load()
+	if registered == nil {
+		return nil
+	}
+
+	var md map[string]string
+
+	for _, entry := range *registered {
+		enriched := entry.enricher(ctx)
+		if len(enriched) == 0 {
+			continue
+		}
+
+		if md == nil {
+			md = make(map[string]string, len(enriched))
+		}
+
+		maps.Copy(md, enriched)
+	}
+
+	return md
+}
+
+// WithMetadata adds the metadata to the error.
+// Unlike the arguments, the metadata is not used for localization.
+func (e *Error) WithMetadata(md map[string]string) *Error {
+	if len(md) == 0 {
+		return e
+	}
+
+	if e.metadata == nil {
+		e.metadata = make(map[string]string, len(md))
+	}
+
+	maps.Copy(e.metadata, md)
+
+	return e
+}
+
+// Metadata returns the metadata of the error, including the one added by the context enrichers.
+func (e Error) Metadata() map[string]string {
+	return e.metadata
+}
+
+// PublicMetadata returns the metadata with the keys allowed by SetMetadataAllowList.
+// It's the metadata sent by the encoders.
+func (e Error) PublicMetadata() map[string]string {
+	allowed := metadataAllowList.Load()
+	if allowed == nil || len(e.metadata) == 0 {
+		return nil
+	}
+
+	var md map[string]string
+
+	for k, v := range e.metadata {
+		if _, ok := (*allowed)[k]; !ok {
+			continue
+		}
+
+		if md == nil {
+			md = map[string]string{}
+		}
+
+		md[k] = v
+	}
+
+	return md
+}
@@ -0,0 +1,48 @@
+package zeerr_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+type requestIDCtxKey struct{}
+
+func TestError_Metadata(t *testing.T) {
+	unregister := zeerr.RegisterContextEnricher(func(ctx context.Context) map[string]string {
+		requestID, ok := ctx.Value(requestIDCtxKey{}).(string)
+		if !ok {
+			return nil
+		}
+
+		return map[string]string{"request_id": requestID, "user_ip": "127.0.0.1"}
+	})
+	t.Cleanup(unregister)
+
+	ctx := context.WithValue(context.Background(), requestIDCtxKey{}, "req_1")
+	err := zeerr.NewError(ctx, &testLocalizer{}, "invalid_form", 400, codes.InvalidArgument, nil)
+
+	assert.Equal(t, map[string]string{"request_id": "req_1", "user_ip": "127.0.0.1"}, err.Metadata())
+	assert.Nil(t, err.PublicMetadata())
+	assert.Nil(t, zeerr.NewError(context.Background(), &testLocalizer{}, "invalid_form", 400, codes.InvalidArgument, nil).Metadata())
+
+	zeerr.SetMetadataAllowList("request_id")
+	t.Cleanup(func() { zeerr.SetMetadataAllowList() })
+
+	b, jsonErr := json.Marshal(err)
+	require.NoError(t, jsonErr)
+	assert.Contains(t, string(b), `"metadata":{"request_id":"req_1"}`)
+
+	var decoded zeerr.Error
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, map[string]string{"request_id": "req_1"}, decoded.Metadata())
+
+	unregister()
+	assert.Nil(t, zeerr.NewError(ctx, &testLocalizer{}, "invalid_form", 400, codes.InvalidArgument, nil).Metadata())
+}
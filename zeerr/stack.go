@@ -0,0 +1,67 @@
+package zeerr
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+const maxStackDepth = 32
+
+var captureStackTraces atomic.Bool
+
+// SetStackTraceCapture enables or disables capturing of the stack traces by NewError.
+// It's disabled by default, as capturing costs an allocation and a walk of the stack on each error.
+func SetStackTraceCapture(enabled bool) {
+	captureStackTraces.Store(enabled)
+}
+
+// StackTrace is the stack trace of the place the error was created at.
+// It's only kept in the process, the encoders never send it.
+type StackTrace []uintptr
+
+// Frames returns the frames of the stack trace.
+func (st StackTrace) Frames() *runtime.Frames {
+	return runtime.CallersFrames(st)
+}
+
+// Lines returns the stack trace formatted as lines of `function file:line`.
+func (st StackTrace) Lines() []string {
+	if len(st) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(st))
+	frames := st.Frames()
+
+	for {
+		frame, more := frames.Next()
+		lines = append(lines, fmt.Sprintf("%s %s:%d", frame.Function, frame.File, frame.Line))
+
+		if !more {
+			break
+		}
+	}
+
+	return lines
+}
+
+func (st StackTrace) String() string {
+	return strings.Join(st.Lines(), "\n")
+}
+
+// callers captures the stack trace starting from the caller of the function calling NewError,
+// i.e. the frames of the generated constructor are skipped.
+func callers() StackTrace {
+	if !captureStackTraces.Load() {
+		return nil
+	}
+
+	var pcs [maxStackDepth]uintptr
+
+	// Skip runtime.Callers, callers, NewError and the generated constructor.
+	n := runtime.Callers(4, pcs[:])
+
+	return StackTrace(append([]uintptr(nil), pcs[:n]...))
+}
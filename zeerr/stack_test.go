@@ -0,0 +1,36 @@
+package zeerr_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+func newTestError(ctx context.Context) *zeerr.Error {
+	return zeerr.NewError(ctx, &testLocalizer{}, "test_error", 500, codes.Internal, nil)
+}
+
+func TestError_StackTrace(t *testing.T) {
+	assert.Nil(t, newTestError(context.Background()).StackTrace())
+
+	zeerr.SetStackTraceCapture(true)
+	t.Cleanup(func() { zeerr.SetStackTraceCapture(false) })
+
+	err := newTestError(context.Background())
+
+	lines := err.StackTrace().Lines()
+	require.NotEmpty(t, lines)
+	assert.Contains(t, lines[0], "zeerr_test.TestError_StackTrace ")
+	assert.Contains(t, fmt.Sprintf("%+v", err), "stack trace:\n        github.com/amanbolat/zederr/zeerr_test.TestError_StackTrace")
+
+	b, jsonErr := json.Marshal(err)
+	require.NoError(t, jsonErr)
+	assert.NotContains(t, string(b), "TestError_StackTrace")
+}
@@ -3,20 +3,40 @@ package zeerr
 import (
 	"bytes"
 	"context"
+	"sync"
+	"time"
 
 	"golang.org/x/text/language"
 	"google.golang.org/grpc/codes"
 )
 
 // Error represents a standardized error.
+//
+// Errors created with NewError are localized lazily: the message is rendered
+// on the first call of Message, or in another locale with Localize.
 type Error struct {
 	id          string
 	httpCode    int
 	grpcCode    codes.Code
 	arguments   map[string]any
-	message     string
+	message     *lazyMessage
+	localizer   Localizer
+	locale      language.Tag
+	timeZone    *time.Location
 	causes      []*Error
 	internalErr error
+	stackTrace  StackTrace
+	// target is the field or the part of the input the error refers to.
+	target string
+	// metadata holds the non-localized data, e.g. the request ID.
+	metadata map[string]string
+}
+
+// lazyMessage renders the message once. It's stored as a pointer,
+// so the copies of the Error share the rendered message.
+type lazyMessage struct {
+	once sync.Once
+	msg  string
 }
 
 func RestoreError(
@@ -32,12 +52,17 @@ func RestoreError(
 		httpCode:  httpCode,
 		grpcCode:  grpcCode,
 		arguments: arguments,
-		message:   message,
+		message:   &lazyMessage{msg: message},
 		causes:    causes,
 	}
 }
 
 // NewError creates a new Error.
+// The locale is negotiated from the preferred locales in the context,
+// but the message is not rendered until it's requested.
+// NOTE: it's meant to be used only by the generated code.
+// If enabled with SetStackTraceCapture, the stack trace is captured starting from the caller of the generated constructor.
+// If the localizer is nil, e.g. the embedded translations are invalid, the error ID is used as the message.
 func NewError(
 	ctx context.Context,
 	localizer Localizer,
@@ -46,21 +71,27 @@ func NewError(
 	grpcCode codes.Code,
 	arguments map[string]any,
 ) *Error {
-	lang, ok := ctx.Value(LocaleCtxKeyType{}).(language.Tag)
-	if !ok {
-		lang = language.Und
-	}
+	lang := matchLanguage(localizer, LocalesFromContext(ctx))
+	timeZone, _ := TimeZoneFromContext(ctx)
 
-	publicMsg := localizer.LocalizeMessage(id, lang, arguments)
+	message := &lazyMessage{}
+	if localizer == nil {
+		message.msg = id
+	}
 
 	return &Error{
 		id:          id,
 		httpCode:    httpCode,
 		grpcCode:    grpcCode,
 		arguments:   arguments,
-		message:     publicMsg,
+		message:     message,
+		localizer:   localizer,
+		locale:      lang,
+		timeZone:    timeZone,
 		causes:      nil,
 		internalErr: nil,
+		stackTrace:  callers(),
+		metadata:    enrichMetadata(ctx),
 	}
 }
 
@@ -76,8 +107,88 @@ func (e Error) HTTPCode() int {
 	return e.httpCode
 }
 
+// Message returns the message localized in the error's locale.
 func (e Error) Message() string {
-	return e.message
+	if e.message == nil {
+		return ""
+	}
+
+	if e.localizer == nil {
+		return e.message.msg
+	}
+
+	e.message.once.Do(func() {
+		e.message.msg = e.localizer.LocalizeMessage(e.id, e.locale, e.argumentsInTimeZone())
+	})
+
+	return e.message.msg
+}
+
+// argumentsInTimeZone returns the arguments with timestamps converted to the error's time zone.
+func (e Error) argumentsInTimeZone() map[string]any {
+	if e.timeZone == nil {
+		return e.arguments
+	}
+
+	args := make(map[string]any, len(e.arguments))
+
+	for k, v := range e.arguments {
+		if t, ok := v.(time.Time); ok {
+			v = t.In(e.timeZone)
+		}
+
+		args[k] = v
+	}
+
+	return args
+}
+
+// Localize returns a copy of the error with the message and the messages of all the causes
+// localized in the locale closest to the preferred ones.
+// The messages of restored errors can't be localized and are kept as is.
+func (e *Error) Localize(prefs ...language.Tag) *Error {
+	return e.localize(prefs, nil)
+}
+
+// LocalizeContext returns a copy of the error with the message and the messages of all the causes
+// localized with the locales and the time zone stored in the context.
+// The error's locale and time zone are kept if the context has none.
+func (e *Error) LocalizeContext(ctx context.Context) *Error {
+	timeZone, _ := TimeZoneFromContext(ctx)
+
+	return e.localize(LocalesFromContext(ctx), timeZone)
+}
+
+func (e *Error) localize(prefs []language.Tag, timeZone *time.Location) *Error {
+	localized := *e
+
+	if e.localizer != nil {
+		if len(prefs) > 0 {
+			localized.locale = matchLanguage(e.localizer, prefs)
+		}
+
+		if timeZone != nil {
+			localized.timeZone = timeZone
+		}
+
+		localized.message = &lazyMessage{}
+	}
+
+	if len(e.causes) > 0 {
+		localized.causes = make([]*Error, 0, len(e.causes))
+
+		for _, cause := range e.causes {
+			localized.causes = append(localized.causes, cause.localize(prefs, timeZone))
+		}
+	}
+
+	return &localized
+}
+
+// Locale returns the locale used for the message localization.
+// It is language.Und if the locale is unknown.
+func (e Error) Locale() language.Tag {
+	return e.locale
 }
 
 func (e Error) Arguments() map[string]any {
@@ -92,6 +203,17 @@ func (e Error) InternalErr() error {
 	return e.internalErr
 }
 
+// Target returns the target the error refers to. It's empty if the target is not set.
+func (e Error) Target() string {
+	return e.target
+}
+
+// StackTrace returns the stack trace captured on the error creation.
+// It's nil unless enabled with SetStackTraceCapture.
+func (e Error) StackTrace() StackTrace {
+	return e.stackTrace
+}
+
 func (e *Error) WithCauses(causes ...*Error) *Error {
 	for _, c := range causes {
 		if c != nil {
@@ -102,18 +224,88 @@ func (e *Error) WithCauses(causes ...*Error) *Error {
 	return e
 }
 
+// WithTarget sets the target the error refers to, e.g. a JSON Pointer `/address/zip`
+// or a dotted path `address.zip` of a form field.
+// It allows to attach the messages of the causes to the corresponding fields.
+func (e *Error) WithTarget(target string) *Error {
+	e.target = target
+
+	return e
+}
+
+// WithGRPCCode overrides the gRPC code of the error, e.g. to keep the code of a foreign status.
+// The HTTP code is left unchanged, use WithHTTPCode to keep them consistent.
+func (e *Error) WithGRPCCode(code codes.Code) *Error {
+	e.grpcCode = code
+
+	return e
+}
+
+// WithHTTPCode overrides the HTTP code of the error.
+// The gRPC code is left unchanged.
+func (e *Error) WithHTTPCode(code int) *Error {
+	e.httpCode = code
+
+	return e
+}
+
 func (e *Error) WithInternalError(err error) *Error {
 	e.internalErr = err
 
 	return e
 }
 
+// WithLocalizer sets the localizer of the error and its causes, e.g. for the errors decoded from a response.
+// The message is localized again with the localizer in the error's locale, use Localize to change the locale.
+// If the localizer implements MessageChecker, the errors it has no messages for keep their localizers and messages.
+func (e *Error) WithLocalizer(localizer Localizer) *Error {
+	checker, ok := localizer.(MessageChecker)
+	if !ok || checker.HasMessage(e.id) {
+		e.localizer = localizer
+		e.message = &lazyMessage{}
+	}
+
+	for _, cause := range e.causes {
+		cause.WithLocalizer(localizer)
+	}
+
+	return e
+}
+
 func (e *Error) Error() string {
 	return e.formattedErr()
 }
 
+// Is reports whether the target is an Error with the same ID.
+// Messages and arguments are not compared, therefore errors.Is can be used
+// to check for a specific error regardless of how it was constructed.
+func (e *Error) Is(target error) bool {
+	zedErr, ok := target.(*Error)
+	if !ok || zedErr == nil {
+		return false
+	}
+
+	return e.id == zedErr.id
+}
+
+// Unwrap returns the internal error followed by all the causes.
+// It allows errors.Is and errors.As to traverse the whole cause tree.
+func (e *Error) Unwrap() []error {
+	errs := make([]error, 0, len(e.causes)+1)
+
+	if e.internalErr != nil {
+		errs = append(errs, e.internalErr)
+	}
+
+	for _, cause := range e.causes {
+		errs = append(errs, cause)
+	}
+
+	return errs
+}
+
 func (e *Error) formattedErr() string {
-	buf := bytes.NewBuffer([]byte(e.message))
+	buf := bytes.NewBuffer([]byte(e.Message()))
 
 	for _, cause := range e.causes {
 		buf.WriteString("\n\t")
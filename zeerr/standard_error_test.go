@@ -0,0 +1,99 @@
+package zeerr_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+func TestError_Is(t *testing.T) {
+	accountLocked := zeerr.RestoreError("account_locked", 401, codes.Unauthenticated, nil, "", nil)
+	notFound := zeerr.RestoreError("not_found", 404, codes.NotFound, nil, "", nil)
+
+	err := zeerr.RestoreError("invalid_form", 400, codes.InvalidArgument, nil, "form is invalid", nil).
+		WithCauses(
+			zeerr.RestoreError("invalid_field", 400, codes.InvalidArgument, nil, "field is invalid", nil).
+				WithCauses(
+					zeerr.RestoreError("account_locked", 401, codes.Unauthenticated, map[string]any{"user_id": "1"}, "locked", nil),
+				),
+		)
+
+	assert.ErrorIs(t, err, accountLocked)
+	assert.NotErrorIs(t, err, notFound)
+}
+
+func TestError_Unwrap(t *testing.T) {
+	err := zeerr.RestoreError("invalid_form", 400, codes.InvalidArgument, nil, "form is invalid", nil).
+		WithCauses(
+			zeerr.RestoreError("user_not_found", 404, codes.NotFound, nil, "user not found", nil).
+				WithInternalError(sql.ErrNoRows),
+		)
+
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	var zedErr *zeerr.Error
+	if assert.True(t, errors.As(err.Causes()[0], &zedErr)) {
+		assert.Equal(t, "user_not_found", zedErr.ID())
+	}
+
+	assert.Len(t, err.Unwrap(), 1)
+	assert.Len(t, err.Causes()[0].Unwrap(), 1)
+}
+
+type testLocalizer struct {
+	calls int
+}
+
+func (l *testLocalizer) LocalizeMessage(id string, lang language.Tag, _ map[string]any) string {
+	l.calls++
+
+	return id + ":" + lang.String()
+}
+
+func TestError_Localize(t *testing.T) {
+	localizer := &testLocalizer{}
+	ctx := zeerr.ContextWithLocale(context.Background(), language.English)
+
+	err := zeerr.NewError(ctx, localizer, "invalid_form", 400, codes.InvalidArgument, nil).
+		WithCauses(
+			zeerr.NewError(context.Background(), localizer, "invalid_field", 400, codes.InvalidArgument, nil),
+			zeerr.RestoreError("restored", 400, codes.InvalidArgument, nil, "restored message", nil),
+		)
+
+	assert.Equal(t, 0, localizer.calls, "message must not be rendered on construction")
+	assert.Equal(t, "invalid_form:en", err.Message())
+	assert.Equal(t, "invalid_form:en", err.Message())
+	assert.Equal(t, 1, localizer.calls, "message must be rendered once")
+
+	localized := err.Localize(language.Chinese)
+	assert.Equal(t, "invalid_form:zh", localized.Message())
+	assert.Equal(t, language.Chinese, localized.Locale())
+	assert.Equal(t, "invalid_field:zh", localized.Causes()[0].Message())
+	assert.Equal(t, "restored message", localized.Causes()[1].Message())
+
+	assert.Equal(t, "invalid_form:en", err.Message())
+	assert.Equal(t, "invalid_field:und", err.Causes()[0].Message())
+}
+
+func TestNewError_NilLocalizer(t *testing.T) {
+	err := zeerr.NewError(context.Background(), nil, "invalid_form", 400, codes.InvalidArgument, nil)
+
+	assert.Equal(t, "invalid_form", err.Message())
+	assert.Equal(t, "invalid_form", err.Localize(language.German).Message())
+}
+
+func TestError_WithLocalizer(t *testing.T) {
+	err := zeerr.RestoreError("invalid_form", 400, codes.InvalidArgument, nil, "form is invalid", nil).
+		WithCauses(zeerr.RestoreError("invalid_field", 400, codes.InvalidArgument, nil, "field is invalid", nil))
+
+	localized := err.WithLocalizer(&testLocalizer{}).Localize(language.German)
+	assert.Equal(t, "invalid_form:de", localized.Message())
+	assert.Equal(t, "invalid_field:de", localized.Causes()[0].Message())
+}
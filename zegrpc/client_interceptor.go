@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	pbzederrv1 "github.com/amanbolat/zederr/zeproto/v1"
@@ -19,8 +20,41 @@ func defaultClientInterceptorConfig() *clientInterceptorConfig {
 	}
 }
 
+// decodeError decodes the error if it is a status with zederr details attached.
+// Otherwise, the error is returned as is.
+func (c *clientInterceptorConfig) decodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	sts, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	for _, detail := range sts.Details() {
+		if v, ok := detail.(*pbzederrv1.Error); ok {
+			return c.decoder.Decode(v)
+		}
+	}
+
+	if statusDecoder, ok := c.decoder.(StatusDecoder); ok {
+		if zedErr, ok := statusDecoder.DecodeStatus(sts); ok {
+			return zedErr
+		}
+	}
+
+	return err
+}
+
 type ClientInterceptorOption func(config *clientInterceptorConfig)
 
+func WithDecoder(decoder Decoder) ClientInterceptorOption {
+	return func(c *clientInterceptorConfig) {
+		c.decoder = decoder
+	}
+}
+
 func StreamClientInterceptor(opts ...ClientInterceptorOption) grpc.StreamClientInterceptor {
 	cfg := defaultClientInterceptorConfig()
 	for _, opt := range opts {
@@ -28,24 +62,17 @@ func StreamClientInterceptor(opts ...ClientInterceptorOption) grpc.StreamClientI
 	}
 
 	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
-		cltStream, err := streamer(ctx, desc, cc, method, opts...)
+		ctx = outgoingContextWithLocale(ctx)
 
+		cltStream, err := streamer(ctx, desc, cc, method, opts...)
 		if err != nil {
-			sts, ok := status.FromError(err)
-			if !ok {
-				return nil, err
-			}
-
-			for _, detail := range sts.Details() {
-				if v, ok := detail.(*pbzederrv1.Error); ok {
-					zedErr := cfg.decoder.Decode(v)
-
-					return nil, zedErr
-				}
-			}
+			return nil, cfg.decodeError(err)
 		}
 
-		return cltStream, nil
+		return &clientStream{
+			ClientStream: cltStream,
+			cfg:          cfg,
+		}, nil
 	}
 }
 
@@ -56,22 +83,34 @@ func UnaryClientInterceptor(opts ...ClientInterceptorOption) grpc.UnaryClientInt
 	}
 
 	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = outgoingContextWithLocale(ctx)
+
 		err := invoker(ctx, method, req, reply, cc, opts...)
-		if err != nil {
-			sts, ok := status.FromError(err)
-			if !ok {
-				return err
-			}
-
-			for _, detail := range sts.Details() {
-				if v, ok := detail.(*pbzederrv1.Error); ok {
-					zedErr := cfg.decoder.Decode(v)
-
-					return zedErr
-				}
-			}
-		}
 
-		return nil
+		return cfg.decodeError(err)
 	}
 }
+
+// clientStream decodes zederr errors returned by any method of the wrapped grpc.ClientStream.
+type clientStream struct {
+	grpc.ClientStream
+	cfg *clientInterceptorConfig
+}
+
+func (s *clientStream) Header() (metadata.MD, error) {
+	md, err := s.ClientStream.Header()
+
+	return md, s.cfg.decodeError(err)
+}
+
+func (s *clientStream) CloseSend() error {
+	return s.cfg.decodeError(s.ClientStream.CloseSend())
+}
+
+func (s *clientStream) SendMsg(m interface{}) error {
+	return s.cfg.decodeError(s.ClientStream.SendMsg(m))
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	return s.cfg.decodeError(s.ClientStream.RecvMsg(m))
+}
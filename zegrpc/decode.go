@@ -1,7 +1,11 @@
 package zegrpc
 
 import (
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/amanbolat/zederr/zeerr"
 	pbzederrv1 "github.com/amanbolat/zederr/zeproto/v1"
@@ -11,6 +15,12 @@ type Decoder interface {
 	Decode(pbErr *pbzederrv1.Error) *zeerr.Error
 }
 
+// StatusDecoder is implemented by the decoders that can rebuild an error
+// from the status when it has no zederr details attached.
+type StatusDecoder interface {
+	DecodeStatus(sts *status.Status) (*zeerr.Error, bool)
+}
+
 type SimpleDecoder struct{}
 
 func (d SimpleDecoder) Decode(pbErr *pbzederrv1.Error) *zeerr.Error {
@@ -31,7 +41,9 @@ func (d SimpleDecoder) decode(pbErr *pbzederrv1.Error) *zeerr.Error {
 		args,
 		pbErr.Message,
 		nil,
-	)
+	).
+		WithTarget(pbErr.Target).
+		WithMetadata(pbErr.Metadata)
 
 	if len(pbErr.Causes) == 0 {
 		return zedErr
@@ -45,3 +57,94 @@ func (d SimpleDecoder) decode(pbErr *pbzederrv1.Error) *zeerr.Error {
 
 	return zedErr
 }
+
+// InteropDecoder decodes zederr details the same way as SimpleDecoder.
+// If the status has no zederr details, the error is rebuilt from
+// the standard google.rpc error details attached by InteropEncoder.
+type InteropDecoder struct{}
+
+func (d InteropDecoder) Decode(pbErr *pbzederrv1.Error) *zeerr.Error {
+	return SimpleDecoder{}.Decode(pbErr)
+}
+
+// DecodeStatus rebuilds the error from ErrorInfo, LocalizedMessage and BadRequest details.
+// It returns false if the status has no ErrorInfo.
+// ErrorInfo metadata is restored as string arguments.
+// HTTP codes are derived from the gRPC code, and each field violation is restored as a cause
+// with the field used as the ID and the target.
+func (d InteropDecoder) DecodeStatus(sts *status.Status) (*zeerr.Error, bool) {
+	var (
+		errInfo    *errdetails.ErrorInfo
+		localized  *errdetails.LocalizedMessage
+		badRequest *errdetails.BadRequest
+	)
+
+	for _, detail := range sts.Details() {
+		switch v := detail.(type) {
+		case *errdetails.ErrorInfo:
+			errInfo = v
+		case *errdetails.LocalizedMessage:
+			localized = v
+		case *errdetails.BadRequest:
+			badRequest = v
+		}
+	}
+
+	if errInfo == nil {
+		return nil, false
+	}
+
+	args := make(map[string]any, len(errInfo.Metadata))
+	for k, v := range errInfo.Metadata {
+		args[k] = v
+	}
+
+	msg := sts.Message()
+	if localized != nil {
+		msg = localized.Message
+	}
+
+	httpCode := httpCodeFromGRPCCode(sts.Code())
+	zedErr := zeerr.RestoreError(errInfo.Reason, httpCode, sts.Code(), args, msg, nil)
+
+	for _, violation := range badRequest.GetFieldViolations() {
+		zedErr = zedErr.WithCauses(
+			zeerr.RestoreError(violation.Field, httpCode, sts.Code(), map[string]any{}, violation.Description, nil).
+				WithTarget(violation.Field),
+		)
+	}
+
+	return zedErr, true
+}
+
+// httpCodeFromGRPCCode maps gRPC code to HTTP status code as described in google/rpc/code.proto.
+func httpCodeFromGRPCCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.Unknown, codes.Internal, codes.DataLoss:
+		return http.StatusInternalServerError
+	default:
+		return http.StatusInternalServerError
+	}
+}
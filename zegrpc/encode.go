@@ -3,9 +3,12 @@ package zegrpc
 import (
 	"errors"
 	"fmt"
+	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/amanbolat/zederr/zeerr"
@@ -47,8 +50,8 @@ type FullEncoder struct {
 	statusMessage string
 }
 
-func NewFullEncoder(statusCode codes.Code, statusMessage string) SimpleEncoder {
-	return SimpleEncoder{
+func NewFullEncoder(statusCode codes.Code, statusMessage string) FullEncoder {
+	return FullEncoder{
 		statusCode:    statusCode,
 		statusMessage: statusMessage,
 	}
@@ -72,7 +75,7 @@ func (e FullEncoder) Encode(err error) *status.Status {
 }
 
 func (e FullEncoder) encode(zedErr *zeerr.Error) *pbzederrv1.Error {
-	pbArgs, err := structpb.NewStruct(zedErr.Arguments())
+	pbArgs, err := argumentsToStruct(zedErr.Arguments())
 	if err != nil {
 		panic(fmt.Errorf("failed to convert args to structpb: %w", err))
 	}
@@ -84,6 +87,8 @@ func (e FullEncoder) encode(zedErr *zeerr.Error) *pbzederrv1.Error {
 		Message:   zedErr.Message(),
 		Arguments: pbArgs,
 		Causes:    nil,
+		Target:    zedErr.Target(),
+		Metadata:  zedErr.PublicMetadata(),
 	}
 
 	if len(zedErr.Causes()) == 0 {
@@ -101,3 +106,108 @@ func (e FullEncoder) encode(zedErr *zeerr.Error) *pbzederrv1.Error {
 
 	return pbErr
 }
+
+// InteropEncoder encodes errors the same way as FullEncoder and additionally attaches
+// standard google.rpc error details, so the clients unaware of zederr can read them:
+//   - ErrorInfo with the error ID as a reason and stringified arguments as metadata;
+//   - LocalizedMessage with the locale and the message;
+//   - BadRequest with a field violation for each cause.
+type InteropEncoder struct {
+	statusCode    codes.Code
+	statusMessage string
+	domain        string
+}
+
+// NewInteropEncoder creates a new InteropEncoder.
+// The domain is used as ErrorInfo domain, usually it's the name of the service.
+func NewInteropEncoder(statusCode codes.Code, statusMessage string, domain string) InteropEncoder {
+	return InteropEncoder{
+		statusCode:    statusCode,
+		statusMessage: statusMessage,
+		domain:        domain,
+	}
+}
+
+func (e InteropEncoder) Encode(err error) *status.Status {
+	var zedErr *zeerr.Error
+	if !errors.As(err, &zedErr) {
+		return status.New(e.statusCode, e.statusMessage)
+	}
+
+	metadata := make(map[string]string, len(zedErr.Arguments()))
+	for k, v := range zedErr.Arguments() {
+		metadata[k] = stringifyArgument(v)
+	}
+
+	details := []protoadapt.MessageV1{
+		FullEncoder{}.encode(zedErr),
+		&errdetails.ErrorInfo{
+			Reason:   zedErr.ID(),
+			Domain:   e.domain,
+			Metadata: metadata,
+		},
+		&errdetails.LocalizedMessage{
+			Locale:  zedErr.Locale().String(),
+			Message: zedErr.Message(),
+		},
+	}
+
+	if violations := e.fieldViolations(zedErr.Causes()); len(violations) > 0 {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: violations,
+		})
+	}
+
+	sts, err := status.New(zedErr.GRPCCode(), zedErr.Message()).WithDetails(details...)
+	if err != nil {
+		panic(fmt.Errorf("failed to attach details to status: %w", err))
+	}
+
+	return sts
+}
+
+// fieldViolations flattens the cause tree depth-first.
+// The field is the target of the cause, or its ID if the target is not set.
+func (e InteropEncoder) fieldViolations(causes []*zeerr.Error) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	for _, cause := range causes {
+		field := cause.Target()
+		if field == "" {
+			field = cause.ID()
+		}
+
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: cause.Message(),
+		})
+
+		violations = append(violations, e.fieldViolations(cause.Causes())...)
+	}
+
+	return violations
+}
+
+func stringifyArgument(v any) string {
+	if t, ok := v.(time.Time); ok {
+		return t.Format(time.RFC3339Nano)
+	}
+
+	return fmt.Sprint(v)
+}
+
+// argumentsToStruct converts the error arguments to structpb.Struct.
+// Timestamps are converted to RFC 3339 strings, as structpb has no timestamp type.
+func argumentsToStruct(args map[string]any) (*structpb.Struct, error) {
+	converted := make(map[string]any, len(args))
+
+	for k, v := range args {
+		if t, ok := v.(time.Time); ok {
+			v = t.Format(time.RFC3339Nano)
+		}
+
+		converted[k] = v
+	}
+
+	return structpb.NewStruct(converted)
+}
@@ -0,0 +1,138 @@
+package zegrpc_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/amanbolat/zederr/zeerr"
+	"github.com/amanbolat/zederr/zegrpc"
+	pbzederrv1 "github.com/amanbolat/zederr/zeproto/v1"
+)
+
+func TestNewFullEncoder(t *testing.T) {
+	encoder := zegrpc.NewFullEncoder(codes.Internal, "internal error")
+
+	sts := encoder.Encode(newTestError())
+	assert.Equal(t, codes.InvalidArgument, sts.Code())
+	require.Len(t, sts.Details(), 1)
+
+	pbErr, ok := sts.Details()[0].(*pbzederrv1.Error)
+	require.True(t, ok)
+	assert.Equal(t, "invalid_form", pbErr.Id)
+	assert.Len(t, pbErr.Causes, 1)
+
+	sts = encoder.Encode(errors.New("boom"))
+	assert.Equal(t, codes.Internal, sts.Code())
+	assert.Equal(t, "internal error", sts.Message())
+	assert.Empty(t, sts.Details())
+}
+
+func TestInteropEncoder_Encode(t *testing.T) {
+	unlockTime := time.Date(2024, 6, 26, 0, 36, 6, 0, time.UTC)
+
+	zedErr := zeerr.RestoreError("account_locked", 401, codes.Unauthenticated, map[string]any{
+		"failed_attempts": 3,
+		"unlock_time":     unlockTime,
+	}, "Account is locked.", nil).WithCauses(newTestError())
+
+	sts := zegrpc.NewInteropEncoder(codes.Unknown, "unknown error", "auth.example.com").Encode(zedErr)
+	assert.Equal(t, codes.Unauthenticated, sts.Code())
+
+	var standardDetails []protoadapt.MessageV1
+
+	for _, detail := range sts.Details() {
+		switch v := detail.(type) {
+		case *pbzederrv1.Error:
+			assert.Equal(t, "account_locked", v.Id)
+		case *errdetails.ErrorInfo:
+			assert.Equal(t, "account_locked", v.Reason)
+			assert.Equal(t, "auth.example.com", v.Domain)
+			assert.Equal(t, map[string]string{
+				"failed_attempts": "3",
+				"unlock_time":     "2024-06-26T00:36:06Z",
+			}, v.Metadata)
+
+			standardDetails = append(standardDetails, v)
+		case *errdetails.LocalizedMessage:
+			assert.Equal(t, "Account is locked.", v.Message)
+
+			standardDetails = append(standardDetails, v)
+		case *errdetails.BadRequest:
+			assert.Len(t, v.FieldViolations, 2)
+
+			standardDetails = append(standardDetails, v)
+		default:
+			t.Fatalf("unexpected detail %T", detail)
+		}
+	}
+
+	require.Len(t, standardDetails, 3)
+
+	// Simulate a status received from a service that attaches only standard details.
+	interopSts, err := status.New(sts.Code(), sts.Message()).WithDetails(standardDetails...)
+	require.NoError(t, err)
+
+	decoded, ok := zegrpc.InteropDecoder{}.DecodeStatus(interopSts)
+	require.True(t, ok)
+	assert.Equal(t, "account_locked", decoded.ID())
+	assert.Equal(t, 401, decoded.HTTPCode())
+	assert.Equal(t, "Account is locked.", decoded.Message())
+	assert.Equal(t, "3", decoded.Arguments()["failed_attempts"])
+	assert.Equal(t, "2024-06-26T00:36:06Z", decoded.Arguments()["unlock_time"])
+	assert.Len(t, decoded.Causes(), 2)
+}
+
+func TestFullEncoder_Target(t *testing.T) {
+	zedErr := zeerr.RestoreError("invalid_form", 400, codes.InvalidArgument, nil, "Form is invalid.", nil).
+		WithCauses(
+			zeerr.RestoreError("invalid_email", 400, codes.InvalidArgument, nil, "Email is invalid.", nil).
+				WithTarget("contacts.email"),
+		)
+
+	sts := zegrpc.NewFullEncoder(codes.Unknown, "unknown error").Encode(zedErr)
+	require.Len(t, sts.Details(), 1)
+
+	pbErr, ok := sts.Details()[0].(*pbzederrv1.Error)
+	require.True(t, ok)
+	assert.Empty(t, pbErr.Target)
+	require.Len(t, pbErr.Causes, 1)
+	assert.Equal(t, "contacts.email", pbErr.Causes[0].Target)
+
+	decoded := zegrpc.SimpleDecoder{}.Decode(pbErr)
+	require.Len(t, decoded.Causes(), 1)
+	assert.Equal(t, "contacts.email", decoded.Causes()[0].Target())
+
+	details := zegrpc.NewInteropEncoder(codes.Unknown, "unknown error", "example.com").Encode(zedErr).Details()
+	require.Len(t, details, 4)
+
+	badRequest, ok := details[3].(*errdetails.BadRequest)
+	require.True(t, ok)
+	assert.Equal(t, "contacts.email", badRequest.FieldViolations[0].Field)
+}
+
+func TestSimpleDecoder_Decode_Arguments(t *testing.T) {
+	zedErr := zeerr.RestoreError("account_locked", 401, codes.Unauthenticated, map[string]any{
+		"code":        "2024-01-01T00:00:00Z",
+		"unlock_time": time.Date(2024, 6, 26, 0, 36, 6, 0, time.UTC),
+	}, "Account is locked.", nil)
+
+	sts := zegrpc.NewFullEncoder(codes.Unknown, "unknown error").Encode(zedErr)
+	require.Len(t, sts.Details(), 1)
+
+	pbErr, ok := sts.Details()[0].(*pbzederrv1.Error)
+	require.True(t, ok)
+
+	decoded := zegrpc.SimpleDecoder{}.Decode(pbErr)
+	assert.Equal(t, map[string]any{
+		"code":        "2024-01-01T00:00:00Z",
+		"unlock_time": "2024-06-26T00:36:06Z",
+	}, decoded.Arguments())
+}
@@ -0,0 +1,82 @@
+package zegrpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/status"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+// ErrorConstructorFunc creates a new error, usually by calling a generated constructor.
+type ErrorConstructorFunc func(context.Context) *zeerr.Error
+
+// StatusErrorConstructorFunc creates a new error for the foreign gRPC status,
+// e.g. by choosing a generated constructor depending on the status code.
+type StatusErrorConstructorFunc func(context.Context, *status.Status) *zeerr.Error
+
+// ChainErrorMappers returns an ErrorMapperFunc that calls the mappers in order.
+// The error returned by a mapper is passed to the next one,
+// until one of them returns a zeerr.Error.
+func ChainErrorMappers(mappers ...ErrorMapperFunc) ErrorMapperFunc {
+	return func(ctx context.Context, err error) error {
+		for _, mapper := range mappers {
+			if isZedErr(err) {
+				return err
+			}
+
+			err = mapper(ctx, err)
+		}
+
+		return err
+	}
+}
+
+// ContextErrorMapper maps context.Canceled and context.DeadlineExceeded errors
+// to the errors created by the corresponding constructors.
+// The original error is kept as the internal error.
+// A nil constructor leaves the corresponding error unchanged.
+func ContextErrorMapper(canceled, deadlineExceeded ErrorConstructorFunc) ErrorMapperFunc {
+	return func(ctx context.Context, err error) error {
+		if isZedErr(err) {
+			return err
+		}
+
+		switch {
+		case errors.Is(err, context.Canceled) && canceled != nil:
+			return canceled(ctx).WithInternalError(err)
+		case errors.Is(err, context.DeadlineExceeded) && deadlineExceeded != nil:
+			return deadlineExceeded(ctx).WithInternalError(err)
+		default:
+			return err
+		}
+	}
+}
+
+// StatusErrorMapper maps gRPC status errors, e.g. returned by downstream services that don't use zederr,
+// to the errors created by the constructor. The created error gets the code of the status
+// and the HTTP code corresponding to it, and the original error is kept as the internal error.
+func StatusErrorMapper(constructor StatusErrorConstructorFunc) ErrorMapperFunc {
+	return func(ctx context.Context, err error) error {
+		if isZedErr(err) {
+			return err
+		}
+
+		sts, ok := status.FromError(err)
+		if !ok || sts == nil {
+			return err
+		}
+
+		return constructor(ctx, sts).
+			WithGRPCCode(sts.Code()).
+			WithHTTPCode(httpCodeFromGRPCCode(sts.Code())).
+			WithInternalError(err)
+	}
+}
+
+func isZedErr(err error) bool {
+	var zedErr *zeerr.Error
+
+	return errors.As(err, &zedErr)
+}
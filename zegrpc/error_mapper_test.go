@@ -0,0 +1,68 @@
+package zegrpc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/amanbolat/zederr/zeerr"
+	"github.com/amanbolat/zederr/zegrpc"
+)
+
+func TestChainErrorMappers(t *testing.T) {
+	newCanceled := func(_ context.Context) *zeerr.Error {
+		return zeerr.RestoreError("canceled", 499, codes.Canceled, nil, "Request was canceled.", nil)
+	}
+
+	newUpstream := func(_ context.Context, _ *status.Status) *zeerr.Error {
+		return zeerr.RestoreError("upstream", http.StatusBadGateway, codes.Unavailable, nil, "Upstream failed.", nil)
+	}
+
+	mapper := zegrpc.ChainErrorMappers(
+		zegrpc.ContextErrorMapper(newCanceled, nil),
+		zegrpc.StatusErrorMapper(newUpstream),
+	)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		err        error
+		expectedID string
+		code       codes.Code
+		httpCode   int
+	}{
+		{name: "canceled", err: fmt.Errorf("query: %w", context.Canceled), expectedID: "canceled", code: codes.Canceled, httpCode: 499},
+		{name: "deadline exceeded is not mapped", err: context.DeadlineExceeded, expectedID: "", code: codes.OK, httpCode: 0},
+		{name: "foreign status", err: status.Error(codes.NotFound, "not found"), expectedID: "upstream", code: codes.NotFound, httpCode: http.StatusNotFound},
+		{name: "zederr is not mapped", err: newTestError(), expectedID: "invalid_form", code: codes.InvalidArgument, httpCode: http.StatusBadRequest},
+		{name: "other error", err: io.EOF, expectedID: "", code: codes.OK, httpCode: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mappedErr := mapper(ctx, tt.err)
+
+			var zedErr *zeerr.Error
+			if tt.expectedID == "" {
+				require.False(t, errors.As(mappedErr, &zedErr))
+				assert.Equal(t, tt.err, mappedErr)
+
+				return
+			}
+
+			require.True(t, errors.As(mappedErr, &zedErr))
+			assert.Equal(t, tt.expectedID, zedErr.ID())
+			assert.Equal(t, tt.code, zedErr.GRPCCode())
+			assert.Equal(t, tt.httpCode, zedErr.HTTPCode())
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package zegrpc_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/amanbolat/zederr/zeerr"
+	"github.com/amanbolat/zederr/zegrpc"
+)
+
+type testHandlers struct {
+	unary  func(ctx context.Context) error
+	stream func(ss grpc.ServerStream) error
+}
+
+func newTestServiceDesc(handlers testHandlers) *grpc.ServiceDesc {
+	return &grpc.ServiceDesc{
+		ServiceName: "test.Service",
+		HandlerType: (*any)(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "Unary",
+				Handler: func(_ any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+					if err := dec(&emptypb.Empty{}); err != nil {
+						return nil, err
+					}
+
+					handler := func(ctx context.Context, _ any) (any, error) {
+						return &emptypb.Empty{}, handlers.unary(ctx)
+					}
+
+					info := &grpc.UnaryServerInfo{Server: nil, FullMethod: "/test.Service/Unary"}
+
+					return interceptor(ctx, &emptypb.Empty{}, info, handler)
+				},
+			},
+		},
+		Streams: []grpc.StreamDesc{
+			{
+				StreamName: "Stream",
+				Handler: func(_ any, stream grpc.ServerStream) error {
+					return handlers.stream(stream)
+				},
+				ServerStreams: true,
+				ClientStreams: false,
+			},
+		},
+		Metadata: nil,
+	}
+}
+
+func newTestClient(t *testing.T, handlers testHandlers, serverOpts []zegrpc.ServerInterceptorOption, clientOpts []zegrpc.ClientInterceptorOption) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(zegrpc.UnaryServerInterceptor(serverOpts...)),
+		grpc.ChainStreamInterceptor(zegrpc.StreamServerInterceptor(serverOpts...)),
+	)
+	srv.RegisterService(newTestServiceDesc(handlers), struct{}{})
+
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(zegrpc.UnaryClientInterceptor(clientOpts...)),
+		grpc.WithChainStreamInterceptor(zegrpc.StreamClientInterceptor(clientOpts...)),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func newTestError() *zeerr.Error {
+	return zeerr.RestoreError("invalid_form", 400, codes.InvalidArgument, map[string]any{"form": "sign_up"}, "Form is invalid.", nil).
+		WithCauses(zeerr.RestoreError("invalid_field", 400, codes.InvalidArgument, nil, "Field is invalid.", nil))
+}
+
+func TestStreamClientInterceptor(t *testing.T) {
+	conn := newTestClient(t, testHandlers{
+		unary: nil,
+		stream: func(ss grpc.ServerStream) error {
+			if err := ss.RecvMsg(&emptypb.Empty{}); err != nil {
+				return err
+			}
+
+			if err := ss.SendMsg(&emptypb.Empty{}); err != nil {
+				return err
+			}
+
+			return newTestError()
+		},
+	}, []zegrpc.ServerInterceptorOption{
+		zegrpc.WithEncoder(zegrpc.NewFullEncoder(codes.Unknown, "unknown error")),
+	}, nil)
+
+	stream, err := conn.NewStream(context.Background(), &grpc.StreamDesc{StreamName: "Stream", ServerStreams: true}, "/test.Service/Stream")
+	require.NoError(t, err)
+	require.NoError(t, stream.SendMsg(&emptypb.Empty{}))
+	require.NoError(t, stream.CloseSend())
+	require.NoError(t, stream.RecvMsg(&emptypb.Empty{}))
+
+	err = stream.RecvMsg(&emptypb.Empty{})
+
+	var zedErr *zeerr.Error
+	require.True(t, errors.As(err, &zedErr))
+	assert.Equal(t, "invalid_form", zedErr.ID())
+	assert.Equal(t, "sign_up", zedErr.Arguments()["form"])
+	assert.Len(t, zedErr.Causes(), 1)
+}
+
+func TestUnaryClientInterceptor_NotZedErr(t *testing.T) {
+	conn := newTestClient(t, testHandlers{
+		unary: func(_ context.Context) error {
+			return io.ErrUnexpectedEOF
+		},
+		stream: nil,
+	}, nil, nil)
+
+	err := conn.Invoke(context.Background(), "/test.Service/Unary", &emptypb.Empty{}, &emptypb.Empty{})
+	require.Error(t, err)
+	assert.Equal(t, codes.Unknown, status.Code(err))
+}
+
+func TestInterceptors_LocalePropagation(t *testing.T) {
+	var unaryLang, streamLang language.Tag
+
+	conn := newTestClient(t, testHandlers{
+		unary: func(ctx context.Context) error {
+			unaryLang, _ = zeerr.LocaleFromContext(ctx)
+
+			return nil
+		},
+		stream: func(ss grpc.ServerStream) error {
+			streamLang, _ = zeerr.LocaleFromContext(ss.Context())
+
+			return nil
+		},
+	}, nil, nil)
+
+	ctx := zeerr.ContextWithLocale(context.Background(), language.Chinese)
+
+	err := conn.Invoke(ctx, "/test.Service/Unary", &emptypb.Empty{}, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, language.Chinese, unaryLang)
+
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{StreamName: "Stream", ServerStreams: true}, "/test.Service/Stream")
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseSend())
+	require.ErrorIs(t, stream.RecvMsg(&emptypb.Empty{}), io.EOF)
+	assert.Equal(t, language.Chinese, streamLang)
+}
+
+func TestServerInterceptors_Recovery(t *testing.T) {
+	var internalErr error
+
+	conn := newTestClient(t, testHandlers{
+		unary: func(_ context.Context) error {
+			panic("boom")
+		},
+		stream: func(_ grpc.ServerStream) error {
+			panic(io.ErrUnexpectedEOF)
+		},
+	}, []zegrpc.ServerInterceptorOption{
+		zegrpc.WithRecovery(nil),
+		zegrpc.WithErrorMapper(func(_ context.Context, err error) error {
+			var zedErr *zeerr.Error
+			if errors.As(err, &zedErr) {
+				internalErr = zedErr.InternalErr()
+			}
+
+			return err
+		}),
+		zegrpc.WithEncoder(zegrpc.NewFullEncoder(codes.Unknown, "unknown error")),
+	}, nil)
+
+	err := conn.Invoke(context.Background(), "/test.Service/Unary", &emptypb.Empty{}, &emptypb.Empty{})
+
+	var zedErr *zeerr.Error
+	require.True(t, errors.As(err, &zedErr))
+	assert.Equal(t, "internal", zedErr.ID())
+	assert.Equal(t, codes.Internal, zedErr.GRPCCode())
+
+	var panicErr *zegrpc.PanicError
+	require.True(t, errors.As(internalErr, &panicErr))
+	assert.Equal(t, "boom", panicErr.Value)
+	assert.NotEmpty(t, panicErr.Stack)
+
+	stream, err := conn.NewStream(context.Background(), &grpc.StreamDesc{StreamName: "Stream", ServerStreams: true}, "/test.Service/Stream")
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseSend())
+
+	err = stream.RecvMsg(&emptypb.Empty{})
+	require.True(t, errors.As(err, &zedErr))
+	assert.Equal(t, "internal", zedErr.ID())
+	assert.ErrorIs(t, internalErr, io.ErrUnexpectedEOF)
+}
+
+func TestServerInterceptors_Recovery_NilError(t *testing.T) {
+	conn := newTestClient(t, testHandlers{
+		unary: func(_ context.Context) error {
+			panic("boom")
+		},
+		stream: func(_ grpc.ServerStream) error {
+			panic("boom")
+		},
+	}, []zegrpc.ServerInterceptorOption{
+		zegrpc.WithRecovery(func(_ context.Context) *zeerr.Error {
+			return nil
+		}),
+		zegrpc.WithEncoder(zegrpc.NewFullEncoder(codes.Unknown, "unknown error")),
+	}, nil)
+
+	err := conn.Invoke(context.Background(), "/test.Service/Unary", &emptypb.Empty{}, &emptypb.Empty{})
+
+	var zedErr *zeerr.Error
+	require.True(t, errors.As(err, &zedErr))
+	assert.Equal(t, "internal", zedErr.ID())
+	assert.Equal(t, codes.Internal, zedErr.GRPCCode())
+
+	stream, err := conn.NewStream(context.Background(), &grpc.StreamDesc{StreamName: "Stream", ServerStreams: true}, "/test.Service/Stream")
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseSend())
+
+	err = stream.RecvMsg(&emptypb.Empty{})
+	require.True(t, errors.As(err, &zedErr))
+	assert.Equal(t, "internal", zedErr.ID())
+}
@@ -0,0 +1,56 @@
+package zegrpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+// LocaleMetadataKey is the metadata key used to propagate the caller locale.
+const LocaleMetadataKey = "accept-language"
+
+// outgoingContextWithLocale writes the locales stored in the context to the outgoing metadata.
+// The metadata is not modified if the caller has already set the key.
+func outgoingContextWithLocale(ctx context.Context) context.Context {
+	langs := zeerr.LocalesFromContext(ctx)
+	if len(langs) == 0 {
+		return ctx
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if len(md.Get(LocaleMetadataKey)) > 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, LocaleMetadataKey, zeerr.FormatAcceptLanguage(langs...))
+}
+
+// incomingContextWithLocale parses the locales from the incoming metadata
+// and stores them in the context with zeerr.ContextWithLocales.
+func incomingContextWithLocale(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	for _, val := range md.Get(LocaleMetadataKey) {
+		if langs := zeerr.ParseAcceptLanguage(val); len(langs) > 0 {
+			return zeerr.ContextWithLocales(ctx, langs...)
+		}
+	}
+
+	return ctx
+}
+
+// serverStream overrides the context of the wrapped grpc.ServerStream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -2,28 +2,105 @@ package zegrpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+const (
+	defaultPanicErrorID      = "internal"
+	defaultPanicErrorMessage = "internal error"
 )
 
 type ErrorMapperFunc func(context.Context, error) error
 
+// RecoveryFunc creates an error returned to the client when the handler panics.
+// It must return a new error on each call, as the panic is attached to it as the internal error.
+type RecoveryFunc func(context.Context) *zeerr.Error
+
+// PanicError is the internal error of the errors created from recovered panics.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+
+	return err
+}
+
 func defaultErrMapper(_ context.Context, err error) error {
 	return err
 }
 
+func defaultRecoveryFunc(_ context.Context) *zeerr.Error {
+	return zeerr.RestoreError(
+		defaultPanicErrorID,
+		http.StatusInternalServerError,
+		codes.Internal,
+		nil,
+		defaultPanicErrorMessage,
+		nil,
+	)
+}
+
 type serverInterceptorConfig struct {
 	errMapperFunc ErrorMapperFunc
+	recoveryFunc  RecoveryFunc
 	encoder       Encoder
 }
 
 func defaultServerInterceptorConfig() *serverInterceptorConfig {
 	return &serverInterceptorConfig{
 		errMapperFunc: defaultErrMapper,
+		recoveryFunc:  nil,
 		encoder:       NewSimpleEncoder(defaultStatusCode, defaultStatusMessage),
 	}
 }
 
+// handleError maps and encodes the error returned by the handler.
+// The error is localized in the caller locale and time zone, if they are known.
+func (c *serverInterceptorConfig) handleError(ctx context.Context, err error) error {
+	mappedErr := c.errMapperFunc(ctx, err)
+
+	var zedErr *zeerr.Error
+	if errors.As(mappedErr, &zedErr) {
+		mappedErr = zedErr.LocalizeContext(ctx)
+	}
+
+	sts := c.encoder.Encode(mappedErr)
+
+	return sts.Err()
+}
+
+// recoverPanic converts the recovered panic into an error created by the recovery func.
+// The default error is used if the recovery func returns nil.
+func (c *serverInterceptorConfig) recoverPanic(ctx context.Context, p any) error {
+	panicErr := &PanicError{
+		Value: p,
+		Stack: debug.Stack(),
+	}
+
+	zedErr := c.recoveryFunc(ctx)
+	if zedErr == nil {
+		zedErr = defaultRecoveryFunc(ctx)
+	}
+
+	return c.handleError(ctx, zedErr.WithInternalError(panicErr))
+}
+
 type ServerInterceptorOption func(*serverInterceptorConfig)
 
 func WithErrorMapper(errMapperFunc ErrorMapperFunc) ServerInterceptorOption {
@@ -38,6 +115,20 @@ func WithEncoder(encoder Encoder) ServerInterceptorOption {
 	}
 }
 
+// WithRecovery enables recovery from panics in handlers.
+// The panic value and the stack are attached as the internal error of the error created by the recovery func,
+// then the error is passed through the error mapper and the encoder.
+// If the recovery func is nil or returns nil, an error with `internal` ID and codes.Internal is used.
+func WithRecovery(recoveryFunc RecoveryFunc) ServerInterceptorOption {
+	return func(c *serverInterceptorConfig) {
+		if recoveryFunc == nil {
+			recoveryFunc = defaultRecoveryFunc
+		}
+
+		c.recoveryFunc = recoveryFunc
+	}
+}
+
 func StreamServerInterceptor(opts ...ServerInterceptorOption) grpc.StreamServerInterceptor {
 	cfg := defaultServerInterceptorConfig()
 
@@ -45,16 +136,26 @@ func StreamServerInterceptor(opts ...ServerInterceptorOption) grpc.StreamServerI
 		opt(cfg)
 	}
 
-	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		err := handler(srv, ss)
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ss = &serverStream{
+			ServerStream: ss,
+			ctx:          incomingContextWithLocale(ss.Context()),
+		}
+
+		if cfg.recoveryFunc != nil {
+			defer func() {
+				if p := recover(); p != nil {
+					err = cfg.recoverPanic(ss.Context(), p)
+				}
+			}()
+		}
+
+		err = handler(srv, ss)
 		if err == nil {
 			return nil
 		}
 
-		zedErr := cfg.errMapperFunc(ss.Context(), err)
-		sts := cfg.encoder.Encode(zedErr)
-
-		return sts.Err()
+		return cfg.handleError(ss.Context(), err)
 	}
 }
 
@@ -65,15 +166,23 @@ func UnaryServerInterceptor(opts ...ServerInterceptorOption) grpc.UnaryServerInt
 		opt(cfg)
 	}
 
-	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		resp, err := handler(ctx, req)
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		ctx = incomingContextWithLocale(ctx)
+
+		if cfg.recoveryFunc != nil {
+			defer func() {
+				if p := recover(); p != nil {
+					resp = nil
+					err = cfg.recoverPanic(ctx, p)
+				}
+			}()
+		}
+
+		resp, err = handler(ctx, req)
 		if err == nil {
 			return resp, nil
 		}
 
-		zedErr := cfg.errMapperFunc(ctx, err)
-		sts := cfg.encoder.Encode(zedErr)
-
-		return resp, sts.Err()
+		return resp, cfg.handleError(ctx, err)
 	}
 }
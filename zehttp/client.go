@@ -0,0 +1,107 @@
+package zehttp
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+const defaultMaxErrorBodySize = 1 << 20
+
+type clientConfig struct {
+	decoder          Decoder
+	maxErrorBodySize int64
+}
+
+func defaultClientConfig() *clientConfig {
+	return &clientConfig{
+		decoder:          ChainDecoders(JSONDecoder{}, ProblemDecoder{}),
+		maxErrorBodySize: defaultMaxErrorBodySize,
+	}
+}
+
+type ClientOption func(*clientConfig)
+
+// WithDecoder sets the decoder of the error responses.
+// By default, both JSONEncoder and ProblemEncoder responses are decoded.
+func WithDecoder(decoder Decoder) ClientOption {
+	return func(c *clientConfig) {
+		c.decoder = decoder
+	}
+}
+
+// WithMaxErrorBodySize limits the number of bytes read from the error response body.
+func WithMaxErrorBodySize(size int64) ClientOption {
+	return func(c *clientConfig) {
+		c.maxErrorBodySize = size
+	}
+}
+
+type transport struct {
+	base http.RoundTripper
+	cfg  *clientConfig
+}
+
+// NewTransport wraps the base http.RoundTripper.
+// The locales from the request context are sent as `Accept-Language` header,
+// and non-2xx responses with an encoded zederr error are returned as *zeerr.Error.
+// If the base is nil, http.DefaultTransport is used.
+func NewTransport(base http.RoundTripper, opts ...ClientOption) http.RoundTripper {
+	cfg := defaultClientConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &transport{
+		base: base,
+		cfg:  cfg,
+	}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if langs := zeerr.LocalesFromContext(req.Context()); len(langs) > 0 && req.Header.Get(headerAcceptLang) == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(headerAcceptLang, zeerr.FormatAcceptLanguage(langs...))
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, t.cfg.maxErrorBodySize))
+	if err != nil {
+		_ = resp.Body.Close()
+
+		return nil, err
+	}
+
+	zedErr, ok := t.cfg.decoder.Decode(resp.Header.Get(headerContentType), body)
+	if !ok {
+		resp.Body = readCloser{
+			Reader: io.MultiReader(bytes.NewReader(body), resp.Body),
+			Closer: resp.Body,
+		}
+
+		return resp, nil
+	}
+
+	_ = resp.Body.Close()
+
+	return nil, zedErr
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
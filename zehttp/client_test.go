@@ -0,0 +1,93 @@
+package zehttp_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+
+	"github.com/amanbolat/zederr/zeerr"
+	"github.com/amanbolat/zederr/zehttp"
+)
+
+func TestTransport_RoundTrip(t *testing.T) {
+	srv := httptest.NewServer(zehttp.LocaleMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ok" {
+			_, _ = io.WriteString(w, "ok")
+
+			return
+		}
+
+		lang, _ := zeerr.LocaleFromContext(r.Context())
+
+		zehttp.WriteError(w, r, zeerr.RestoreError("invalid_form", 400, codes.InvalidArgument, nil, lang.String(), nil).
+			WithCauses(zeerr.RestoreError("invalid_field", 400, codes.InvalidArgument, nil, "", nil)))
+	})))
+	defer srv.Close()
+
+	client := &http.Client{Transport: zehttp.NewTransport(nil)}
+	ctx := zeerr.ContextWithLocale(context.Background(), language.Chinese)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/ok", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/fail", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req) //nolint:bodyclose // response is nil on error
+	require.Error(t, err)
+
+	var zedErr *zeerr.Error
+	require.True(t, errors.As(err, &zedErr))
+	assert.Equal(t, "invalid_form", zedErr.ID())
+	assert.Equal(t, "zh", zedErr.Message())
+	assert.Len(t, zedErr.Causes(), 1)
+}
+
+func TestTransport_RoundTrip_Problem(t *testing.T) {
+	ew := zehttp.NewErrorWriter(zehttp.WithEncoder(zehttp.NewProblemEncoder("https://example.com/errors")))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ew.WriteError(w, r, zeerr.RestoreError("invalid_form", 400, codes.InvalidArgument, nil, "Form is invalid.", nil).
+			WithCauses(
+				zeerr.RestoreError("contacts/invalid_email", 400, codes.InvalidArgument, map[string]any{
+					"email": "john@",
+				}, "Email is invalid.", nil).WithTarget("/contacts/email"),
+			))
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: zehttp.NewTransport(nil)}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req) //nolint:bodyclose // response is nil on error
+	require.Error(t, err)
+
+	var zedErr *zeerr.Error
+	require.True(t, errors.As(err, &zedErr))
+	assert.Equal(t, "invalid_form", zedErr.ID())
+	assert.Equal(t, 400, zedErr.HTTPCode())
+	assert.Equal(t, codes.InvalidArgument, zedErr.GRPCCode())
+	assert.Equal(t, "Form is invalid.", zedErr.Message())
+	require.Len(t, zedErr.Causes(), 1)
+
+	cause := zedErr.Causes()[0]
+	assert.Equal(t, "contacts/invalid_email", cause.ID())
+	assert.Equal(t, "Email is invalid.", cause.Message())
+	assert.Equal(t, "/contacts/email", cause.Target())
+	assert.Equal(t, map[string]any{"email": "john@"}, cause.Arguments())
+}
@@ -0,0 +1,161 @@
+package zehttp
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+// Decoder decodes an error from the HTTP response body.
+type Decoder interface {
+	// Decode decodes the body. It returns false if the body is not an encoded zederr error.
+	Decode(contentType string, body []byte) (*zeerr.Error, bool)
+}
+
+// ChainDecoders returns a Decoder that tries the decoders in order
+// and returns the error decoded by the first one that succeeds.
+func ChainDecoders(decoders ...Decoder) Decoder {
+	return chainDecoder(decoders)
+}
+
+type chainDecoder []Decoder
+
+func (d chainDecoder) Decode(contentType string, body []byte) (*zeerr.Error, bool) {
+	for _, decoder := range d {
+		if zedErr, ok := decoder.Decode(contentType, body); ok {
+			return zedErr, true
+		}
+	}
+
+	return nil, false
+}
+
+// JSONDecoder decodes errors encoded by JSONEncoder.
+type JSONDecoder struct{}
+
+func (d JSONDecoder) Decode(contentType string, body []byte) (*zeerr.Error, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != JSONContentType {
+		return nil, false
+	}
+
+	var zedErr zeerr.Error
+
+	err = json.Unmarshal(body, &zedErr)
+	if err != nil || zedErr.ID() == "" {
+		return nil, false
+	}
+
+	return &zedErr, true
+}
+
+// ProblemDecoder decodes errors encoded by ProblemEncoder.
+// The error ID is the last path segment of the problem type, whatever the base URI is.
+// Problem Details carry no gRPC code, so it's derived from the status,
+// and timestamp arguments are kept as RFC 3339 strings.
+type ProblemDecoder struct{}
+
+func (d ProblemDecoder) Decode(contentType string, body []byte) (*zeerr.Error, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != ProblemContentType {
+		return nil, false
+	}
+
+	var problem Problem
+
+	err = json.Unmarshal(body, &problem)
+	if err != nil {
+		return nil, false
+	}
+
+	return d.restore(problem)
+}
+
+func (d ProblemDecoder) restore(problem Problem) (*zeerr.Error, bool) {
+	id := problemID(problem.Type)
+	if id == "" {
+		return nil, false
+	}
+
+	causes := make([]*zeerr.Error, 0, len(problem.Causes))
+
+	for _, c := range problem.Causes {
+		cause, ok := d.restore(c)
+		if !ok {
+			return nil, false
+		}
+
+		causes = append(causes, cause)
+	}
+
+	zedErr := zeerr.RestoreError(
+		id,
+		problem.Status,
+		grpcCodeFromHTTPCode(problem.Status),
+		problem.Arguments,
+		problem.Detail,
+		causes,
+	).
+		WithTarget(problem.Target).
+		WithMetadata(problem.Metadata)
+
+	return zedErr, true
+}
+
+// problemID extracts the error ID from the problem type built by ProblemEncoder.
+func problemID(problemType string) string {
+	u, err := url.Parse(problemType)
+	if err != nil {
+		return ""
+	}
+
+	segment := path.Base(u.EscapedPath())
+	if segment == "." || segment == "/" {
+		return ""
+	}
+
+	id, err := url.PathUnescape(segment)
+	if err != nil {
+		return ""
+	}
+
+	return id
+}
+
+// grpcCodeFromHTTPCode maps HTTP status code to gRPC code, the reverse of the mapping in google/rpc/code.proto.
+func grpcCodeFromHTTPCode(httpCode int) codes.Code {
+	switch httpCode {
+	case http.StatusOK:
+		return codes.OK
+	case 499:
+		return codes.Canceled
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusInternalServerError:
+		return codes.Internal
+	default:
+		return codes.Unknown
+	}
+}
@@ -0,0 +1,121 @@
+package zehttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+const (
+	// JSONContentType is the media type of errors encoded by JSONEncoder.
+	JSONContentType = "application/json"
+	// ProblemContentType is the media type of RFC 9457 Problem Details.
+	ProblemContentType = "application/problem+json"
+)
+
+// Encoder encodes an error into the HTTP response body.
+type Encoder interface {
+	// ContentType returns the media type of the encoded body.
+	ContentType() string
+	// Encode encodes the error.
+	Encode(zedErr *zeerr.Error) ([]byte, error)
+}
+
+// JSONEncoder encodes errors using the JSON representation of zeerr.Error.
+type JSONEncoder struct{}
+
+func (e JSONEncoder) ContentType() string {
+	return JSONContentType
+}
+
+func (e JSONEncoder) Encode(zedErr *zeerr.Error) ([]byte, error) {
+	b, err := json.Marshal(zedErr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal error: %w", err)
+	}
+
+	return b, nil
+}
+
+// Problem is the RFC 9457 Problem Details representation of an error.
+// Arguments, target, metadata and causes are added as extension members.
+type Problem struct {
+	Type      string            `json:"type"`
+	Title     string            `json:"title,omitempty"`
+	Status    int               `json:"status"`
+	Detail    string            `json:"detail"`
+	Instance  string            `json:"instance,omitempty"`
+	Arguments map[string]any    `json:"arguments,omitempty"`
+	Target    string            `json:"target,omitempty"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	Causes    []Problem         `json:"causes,omitempty"`
+}
+
+// ProblemEncoder encodes errors as `application/problem+json`.
+type ProblemEncoder struct {
+	baseURI string
+}
+
+// NewProblemEncoder creates a new ProblemEncoder.
+// The problem type is built by appending the error ID to the base URI,
+// e.g. `https://example.com/errors/account_locked`. If the base URI is empty,
+// the error ID is used as a relative URI.
+func NewProblemEncoder(baseURI string) ProblemEncoder {
+	return ProblemEncoder{
+		baseURI: strings.TrimSuffix(baseURI, "/"),
+	}
+}
+
+func (e ProblemEncoder) ContentType() string {
+	return ProblemContentType
+}
+
+func (e ProblemEncoder) Encode(zedErr *zeerr.Error) ([]byte, error) {
+	b, err := json.Marshal(e.Problem(zedErr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal problem details: %w", err)
+	}
+
+	return b, nil
+}
+
+// Problem converts the error into Problem Details.
+func (e ProblemEncoder) Problem(zedErr *zeerr.Error) Problem {
+	problem := Problem{
+		Type:      e.problemType(zedErr.ID()),
+		Title:     http.StatusText(zedErr.HTTPCode()),
+		Status:    zedErr.HTTPCode(),
+		Detail:    zedErr.Message(),
+		Instance:  "",
+		Arguments: zedErr.Arguments(),
+		Target:    zedErr.Target(),
+		Metadata:  zedErr.PublicMetadata(),
+		Causes:    nil,
+	}
+
+	if len(zedErr.Causes()) == 0 {
+		return problem
+	}
+
+	causes := make([]Problem, 0, len(zedErr.Causes()))
+
+	for _, cause := range zedErr.Causes() {
+		causes = append(causes, e.Problem(cause))
+	}
+
+	problem.Causes = causes
+
+	return problem
+}
+
+func (e ProblemEncoder) problemType(id string) string {
+	if e.baseURI == "" {
+		return url.PathEscape(id)
+	}
+
+	return e.baseURI + "/" + url.PathEscape(id)
+}
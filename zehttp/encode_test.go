@@ -0,0 +1,38 @@
+package zehttp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/amanbolat/zederr/zeerr"
+	"github.com/amanbolat/zederr/zehttp"
+)
+
+func TestProblemEncoder_Encode(t *testing.T) {
+	zedErr := zeerr.RestoreError("invalid_form", 400, codes.InvalidArgument, nil, "Form is invalid.", nil).
+		WithCauses(
+			zeerr.RestoreError("account_locked", 401, codes.Unauthenticated, map[string]any{"failed_attempts": 3}, "Account is locked.", nil),
+		)
+
+	enc := zehttp.NewProblemEncoder("https://example.com/errors/")
+	assert.Equal(t, "application/problem+json", enc.ContentType())
+
+	b, err := enc.Encode(zedErr)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "https://example.com/errors/invalid_form",
+		"title": "Bad Request",
+		"status": 400,
+		"detail": "Form is invalid.",
+		"causes": [{
+			"type": "https://example.com/errors/account_locked",
+			"title": "Unauthorized",
+			"status": 401,
+			"detail": "Account is locked.",
+			"arguments": {"failed_attempts": 3}
+		}]
+	}`, string(b))
+}
@@ -0,0 +1,164 @@
+package zehttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+
+	"github.com/amanbolat/zederr/zeerr"
+)
+
+const (
+	defaultErrorID      = "unknown"
+	defaultErrorMessage = "unknown error"
+	headerAcceptLang    = "Accept-Language"
+	headerContentType   = "Content-Type"
+)
+
+// ErrorMapperFunc maps an error returned by the application before it is written to the response.
+type ErrorMapperFunc func(context.Context, error) error
+
+// FallbackErrorFunc creates an error that is written to the response
+// when the mapped error is not a zeerr.Error.
+type FallbackErrorFunc func(context.Context, error) *zeerr.Error
+
+func defaultErrMapper(_ context.Context, err error) error {
+	return err
+}
+
+func defaultFallbackErr(_ context.Context, err error) *zeerr.Error {
+	return zeerr.RestoreError(
+		defaultErrorID,
+		http.StatusInternalServerError,
+		codes.Unknown,
+		nil,
+		defaultErrorMessage,
+		nil,
+	).WithInternalError(err)
+}
+
+type serverConfig struct {
+	errMapperFunc   ErrorMapperFunc
+	fallbackErrFunc FallbackErrorFunc
+	encoder         Encoder
+}
+
+func defaultServerConfig() *serverConfig {
+	return &serverConfig{
+		errMapperFunc:   defaultErrMapper,
+		fallbackErrFunc: defaultFallbackErr,
+		encoder:         JSONEncoder{},
+	}
+}
+
+type ServerOption func(*serverConfig)
+
+func WithErrorMapper(errMapperFunc ErrorMapperFunc) ServerOption {
+	return func(c *serverConfig) {
+		c.errMapperFunc = errMapperFunc
+	}
+}
+
+func WithFallbackError(fallbackErrFunc FallbackErrorFunc) ServerOption {
+	return func(c *serverConfig) {
+		c.fallbackErrFunc = fallbackErrFunc
+	}
+}
+
+func WithEncoder(encoder Encoder) ServerOption {
+	return func(c *serverConfig) {
+		c.encoder = encoder
+	}
+}
+
+// ErrorWriter writes errors to HTTP responses.
+type ErrorWriter struct {
+	cfg *serverConfig
+}
+
+// NewErrorWriter creates a new ErrorWriter.
+func NewErrorWriter(opts ...ServerOption) *ErrorWriter {
+	cfg := defaultServerConfig()
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &ErrorWriter{
+		cfg: cfg,
+	}
+}
+
+var defaultErrorWriter = NewErrorWriter()
+
+// WriteError writes the error to the response using the default ErrorWriter.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	defaultErrorWriter.WriteError(w, r, err)
+}
+
+// WriteError maps the error, encodes it and writes it to the response.
+// The error is localized in the request locale and time zone, and the status code is taken from zeerr.Error HTTPCode.
+func (ew *ErrorWriter) WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	if err == nil {
+		return
+	}
+
+	ctx := r.Context()
+	mappedErr := ew.cfg.errMapperFunc(ctx, err)
+
+	var zedErr *zeerr.Error
+	if !errors.As(mappedErr, &zedErr) {
+		zedErr = ew.cfg.fallbackErrFunc(ctx, mappedErr)
+	}
+
+	zedErr = zedErr.LocalizeContext(ctx)
+
+	body, err := ew.cfg.encoder.Encode(zedErr)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+		return
+	}
+
+	statusCode := zedErr.HTTPCode()
+	if statusCode < 100 || statusCode > 599 {
+		statusCode = http.StatusInternalServerError
+	}
+
+	w.Header().Set(headerContentType, ew.cfg.encoder.ContentType())
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body)
+}
+
+// LocaleMiddleware reads the `Accept-Language` header and stores the preferred locales
+// in the request context with zeerr.ContextWithLocales.
+// If supported locales are provided, only the closest supported one is stored.
+func LocaleMiddleware(supported ...language.Tag) func(http.Handler) http.Handler {
+	var matcher language.Matcher
+	if len(supported) > 0 {
+		matcher = language.NewMatcher(supported)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tags := zeerr.ParseAcceptLanguage(r.Header.Get(headerAcceptLang))
+			if len(tags) == 0 {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			if matcher != nil {
+				_, idx, _ := matcher.Match(tags...)
+				tags = []language.Tag{supported[idx]}
+			}
+
+			ctx := zeerr.ContextWithLocales(r.Context(), tags...)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package zehttp_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+
+	"github.com/amanbolat/zederr/zeerr"
+	"github.com/amanbolat/zederr/zehttp"
+)
+
+func TestErrorWriter_WriteError(t *testing.T) {
+	errNotFound := errors.New("not found")
+
+	writer := zehttp.NewErrorWriter(
+		zehttp.WithErrorMapper(func(_ context.Context, err error) error {
+			if errors.Is(err, errNotFound) {
+				return zeerr.RestoreError("not_found", 404, codes.NotFound, nil, "Not found.", nil).WithInternalError(err)
+			}
+
+			return err
+		}),
+	)
+
+	t.Run("mapped error", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		writer.WriteError(rec, httptest.NewRequest(http.MethodGet, "/", nil), errNotFound)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+		var zedErr zeerr.Error
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &zedErr))
+		assert.Equal(t, "not_found", zedErr.ID())
+	})
+
+	t.Run("fallback error", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		writer.WriteError(rec, httptest.NewRequest(http.MethodGet, "/", nil), errors.New("boom"))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+
+		var zedErr zeerr.Error
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &zedErr))
+		assert.Equal(t, "unknown", zedErr.ID())
+		assert.Equal(t, codes.Unknown, zedErr.GRPCCode())
+	})
+}
+
+func TestLocaleMiddleware(t *testing.T) {
+	var lang language.Tag
+
+	handler := zehttp.LocaleMiddleware(language.English, language.Chinese)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		lang, _ = r.Context().Value(zeerr.LocaleCtxKeyType{}).(language.Tag)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, language.Chinese, lang)
+}
@@ -0,0 +1,82 @@
+package zei18n_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+
+	"github.com/amanbolat/zederr/zeerr"
+	"github.com/amanbolat/zederr/zei18n"
+)
+
+func newBenchmarkLocalizer(b *testing.B) zeerr.Localizer {
+	b.Helper()
+
+	loc, err := zei18n.NewLocalizer("en", map[string][]byte{
+		"en": []byte(`
+[rate_limited_message]
+other = "Too many requests."
+
+[account_locked_message]
+one = "Your account {{ .user_id }} is locked after {{ .failed_attempts }} attempt until {{ .unlock_time }}."
+other = "Your account {{ .user_id }} is locked after {{ .failed_attempts }} attempts until {{ .unlock_time }}."
+`),
+		"zh": []byte(`
+[rate_limited_message]
+other = "请求过多。"
+
+[account_locked_message]
+other = "您的帐户{{ .user_id }}已被锁定({{ .failed_attempts }})，直到{{ .unlock_time }}。"
+`),
+	}, zei18n.WithErrorDefinitions(
+		zei18n.ErrorDefinition{ID: "rate_limited", Arguments: nil, PluralArgument: ""},
+		zei18n.ErrorDefinition{
+			ID: "account_locked",
+			Arguments: []zei18n.ArgumentDefinition{
+				{Name: "user_id"},
+				{Name: "failed_attempts"},
+				{Name: "unlock_time"},
+			},
+			PluralArgument: "failed_attempts",
+		},
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return loc
+}
+
+func BenchmarkNewError_NoArguments(b *testing.B) {
+	loc := newBenchmarkLocalizer(b)
+	ctx := zeerr.ContextWithLocale(context.Background(), language.Chinese)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		err := zeerr.NewError(ctx, loc, "rate_limited", 429, codes.ResourceExhausted, nil)
+		_ = err.Message()
+	}
+}
+
+func BenchmarkNewError_Arguments(b *testing.B) {
+	loc := newBenchmarkLocalizer(b)
+	ctx := zeerr.ContextWithLocale(context.Background(), language.English)
+	unlockTime := time.Date(2024, 6, 26, 0, 36, 6, 0, time.UTC)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		err := zeerr.NewError(ctx, loc, "account_locked", 401, codes.Unauthenticated, map[string]any{
+			"user_id":         "user_1",
+			"failed_attempts": 3,
+			"unlock_time":     unlockTime,
+		})
+		_ = err.Message()
+	}
+}
@@ -0,0 +1,245 @@
+package zei18n
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// NamespaceSeparator separates the namespace and the error ID in the IDs routed by CompositeLocalizer.
+const NamespaceSeparator = "/"
+
+// compositeRoute is the localizer the error ID is routed to.
+type compositeRoute struct {
+	localizer *localizer
+	// id is the error ID without the namespace.
+	id string
+}
+
+// CompositeLocalizer localizes the errors of several catalogs, e.g. the ones generated for different services,
+// by routing each error ID to the catalog it's registered with.
+// It is safe for concurrent use.
+type CompositeLocalizer struct {
+	mu sync.RWMutex
+	// routes maps the error IDs, prefixed by the namespaces if any, to the localizers of their catalogs.
+	routes map[string]compositeRoute
+	// langs holds all the supported languages, the default one of the first catalog is always the first.
+	langs   []language.Tag
+	matcher language.Matcher
+}
+
+// NewCompositeLocalizer creates an empty composite localizer.
+func NewCompositeLocalizer() *CompositeLocalizer {
+	return &CompositeLocalizer{
+		routes:  map[string]compositeRoute{},
+		langs:   nil,
+		matcher: nil,
+	}
+}
+
+// Register adds the catalog of messages, e.g. the one returned by `ErrorMessages` of the generated package.
+// Use WithNamespace to register catalogs with conflicting IDs.
+// An error is returned if any of the IDs is already registered, in which case nothing is registered.
+func (c *CompositeLocalizer) Register(
+	defaultLocale string,
+	messagesMap map[string][]byte,
+	opts ...Option,
+) error {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	err := validateCatalog(cfg, messagesMap)
+	if err != nil {
+		return err
+	}
+
+	loc, err := newLocalizer(cfg, defaultLocale, messagesMap, nil)
+	if err != nil {
+		return err
+	}
+
+	ids, err := catalogErrorIDs(cfg, messagesMap)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	routes := make(map[string]compositeRoute, len(ids))
+
+	for _, id := range ids {
+		routedID := namespacedID(cfg.namespace, id)
+
+		if _, ok := c.routes[routedID]; ok {
+			return fmt.Errorf("error [%s] is already registered", routedID)
+		}
+
+		routes[routedID] = compositeRoute{
+			localizer: loc,
+			id:        id,
+		}
+	}
+
+	for routedID, route := range routes {
+		c.routes[routedID] = route
+	}
+
+	for _, lang := range loc.langs {
+		if !slices.Contains(c.langs, lang) {
+			c.langs = append(c.langs, lang)
+		}
+	}
+
+	c.matcher = language.NewMatcher(c.langs)
+
+	return nil
+}
+
+// MatchLanguage returns the language closest to the preferred ones, supported by any of the catalogs.
+// The default language of the first catalog is returned if none of them matches.
+func (c *CompositeLocalizer) MatchLanguage(prefs ...language.Tag) language.Tag {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.matcher == nil {
+		return language.Und
+	}
+
+	_, idx, confidence := c.matcher.Match(prefs...)
+	if confidence == language.No {
+		return c.langs[0]
+	}
+
+	return c.langs[idx]
+}
+
+// Namespace returns the localizer of the errors registered with the namespace,
+// e.g. to localize the errors decoded from the responses of the service the namespace stands for,
+// which have IDs without the namespace.
+func (c *CompositeLocalizer) Namespace(namespace string) *NamespacedLocalizer {
+	return &NamespacedLocalizer{
+		composite: c,
+		namespace: namespace,
+	}
+}
+
+// HasMessage reports whether the error is registered.
+// The errors that are not registered keep their messages in zeerr.Error.WithLocalizer.
+func (c *CompositeLocalizer) HasMessage(id string) bool {
+	_, ok := c.route(id)
+
+	return ok
+}
+
+// LocalizeMessage localizes error's public message with the catalog the error is registered with.
+// The error ID is returned if the error is not registered.
+func (c *CompositeLocalizer) LocalizeMessage(id string, lang language.Tag, args map[string]any) string {
+	route, ok := c.route(id)
+	if !ok {
+		return id
+	}
+
+	return route.localizer.LocalizeMessage(route.id, lang, args)
+}
+
+// LocalizeDescription localizes error's description with the catalog the error is registered with.
+func (c *CompositeLocalizer) LocalizeDescription(id string, lang language.Tag) string {
+	route, ok := c.route(id)
+	if !ok {
+		return ""
+	}
+
+	return route.localizer.LocalizeDescription(route.id, lang)
+}
+
+// LocalizeArgumentDescription localizes the description of error's argument with the catalog the error is registered with.
+func (c *CompositeLocalizer) LocalizeArgumentDescription(id, arg string, lang language.Tag) string {
+	route, ok := c.route(id)
+	if !ok {
+		return ""
+	}
+
+	return route.localizer.LocalizeArgumentDescription(route.id, arg, lang)
+}
+
+func (c *CompositeLocalizer) route(id string) (compositeRoute, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	route, ok := c.routes[id]
+
+	return route, ok
+}
+
+func namespacedID(namespace, id string) string {
+	if namespace == "" {
+		return id
+	}
+
+	return namespace + NamespaceSeparator + id
+}
+
+// NamespacedLocalizer localizes the errors registered in CompositeLocalizer with the namespace
+// by their IDs without the namespace.
+type NamespacedLocalizer struct {
+	composite *CompositeLocalizer
+	namespace string
+}
+
+// MatchLanguage returns the language closest to the preferred ones, supported by any of the catalogs.
+func (l *NamespacedLocalizer) MatchLanguage(prefs ...language.Tag) language.Tag {
+	return l.composite.MatchLanguage(prefs...)
+}
+
+// HasMessage reports whether the error is registered with the namespace.
+func (l *NamespacedLocalizer) HasMessage(id string) bool {
+	return l.composite.HasMessage(namespacedID(l.namespace, id))
+}
+
+// LocalizeMessage localizes error's public message with the catalog registered with the namespace.
+// The error ID is returned if the error is not registered.
+func (l *NamespacedLocalizer) LocalizeMessage(id string, lang language.Tag, args map[string]any) string {
+	route, ok := l.composite.route(namespacedID(l.namespace, id))
+	if !ok {
+		return id
+	}
+
+	return route.localizer.LocalizeMessage(route.id, lang, args)
+}
+
+// LocalizeDescription localizes error's description with the catalog registered with the namespace.
+func (l *NamespacedLocalizer) LocalizeDescription(id string, lang language.Tag) string {
+	return l.composite.LocalizeDescription(namespacedID(l.namespace, id), lang)
+}
+
+// LocalizeArgumentDescription localizes the description of error's argument with the catalog registered with the namespace.
+func (l *NamespacedLocalizer) LocalizeArgumentDescription(id, arg string, lang language.Tag) string {
+	return l.composite.LocalizeArgumentDescription(namespacedID(l.namespace, id), arg, lang)
+}
+
+// catalogErrorIDs returns the IDs of the errors defined in the config or having messages in the catalog.
+func catalogErrorIDs(cfg *config, messagesMap map[string][]byte) ([]string, error) {
+	ids := sortedKeys(cfg.definitions)
+
+	for lang, data := range messagesMap {
+		file, err := parseMessageFile(lang, data)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, msg := range file.Messages {
+			id, ok := strings.CutSuffix(msg.ID, "_message")
+			if ok && !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,129 @@
+package zei18n_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/amanbolat/zederr/zeerr"
+	"github.com/amanbolat/zederr/zei18n"
+)
+
+func TestCompositeLocalizer(t *testing.T) {
+	loc := zei18n.NewCompositeLocalizer()
+
+	require.NoError(t, loc.Register("en", testMessages))
+	require.NoError(t, loc.Register("en", map[string][]byte{
+		"en": []byte(`
+[user_not_found_message]
+other = "User {{ .user_id }} not found."
+`),
+		"fr": []byte(`
+[user_not_found_message]
+other = "Utilisateur {{ .user_id }} introuvable."
+`),
+	}))
+
+	err := loc.Register("en", map[string][]byte{
+		"en": []byte(`
+[user_not_found_message]
+other = "Not found."
+`),
+	})
+	require.ErrorContains(t, err, "error [user_not_found] is already registered")
+
+	require.NoError(t, loc.Register("en", map[string][]byte{
+		"en": []byte(`
+[user_not_found_message]
+other = "Billing account of {{ .user_id }} not found."
+`),
+	}, zei18n.WithNamespace("billing")))
+
+	args := map[string]any{"failed_attempts": 3, "user_id": "user_1"}
+
+	assert.Equal(t, "您的帐户已被锁定(3)。", loc.LocalizeMessage("account_locked", language.Chinese, args))
+	assert.Equal(t, "Utilisateur user_1 introuvable.", loc.LocalizeMessage("user_not_found", language.French, args))
+	assert.Equal(t, "User user_1 not found.", loc.LocalizeMessage("user_not_found", language.Chinese, args))
+	assert.Equal(t, "unknown", loc.LocalizeMessage("unknown", language.English, args))
+	assert.Equal(t, "Billing account of user_1 not found.", loc.LocalizeMessage("billing/user_not_found", language.English, args))
+	assert.True(t, loc.HasMessage("user_not_found"))
+	assert.True(t, loc.HasMessage("billing/user_not_found"))
+	assert.False(t, loc.HasMessage("unknown"))
+
+	billing := loc.Namespace("billing")
+	assert.Equal(t, "Billing account of user_1 not found.", billing.LocalizeMessage("user_not_found", language.English, args))
+	assert.True(t, billing.HasMessage("user_not_found"))
+	assert.False(t, billing.HasMessage("account_locked"))
+
+	assert.Equal(t, language.French, loc.MatchLanguage(language.MustParse("fr-CA")))
+	assert.Equal(t, language.English, loc.MatchLanguage(language.Japanese))
+}
+
+func TestCompositeLocalizer_DecodedError(t *testing.T) {
+	loc := zei18n.NewCompositeLocalizer()
+	require.NoError(t, loc.Register("en", testMessages))
+
+	// The error as received from a downstream service, with a cause from a catalog that is not registered.
+	body := []byte(`{
+		"id": "account_locked",
+		"http_code": 401,
+		"grpc_code": 16,
+		"message": "Your account is locked (3).",
+		"arguments": {"failed_attempts": 3},
+		"causes": [{
+			"id": "card_declined",
+			"http_code": 402,
+			"grpc_code": 9,
+			"message": "Your card was declined.",
+			"arguments": {},
+			"causes": []
+		}]
+	}`)
+
+	var zedErr zeerr.Error
+	require.NoError(t, json.Unmarshal(body, &zedErr))
+
+	ctx := zeerr.ContextWithLocale(context.Background(), language.Chinese)
+	localized := zedErr.WithLocalizer(loc).LocalizeContext(ctx)
+
+	assert.Equal(t, "您的帐户已被锁定(3)。", localized.Message())
+	require.Len(t, localized.Causes(), 1)
+	assert.Equal(t, "Your card was declined.", localized.Causes()[0].Message())
+}
+
+func TestCompositeLocalizer_DecodedNamespacedError(t *testing.T) {
+	loc := zei18n.NewCompositeLocalizer()
+	require.NoError(t, loc.Register("en", testMessages))
+	require.NoError(t, loc.Register("en", map[string][]byte{
+		"en": []byte(`
+[account_locked_message]
+other = "Billing account is locked."
+`),
+		"zh": []byte(`
+[account_locked_message]
+other = "账单帐户已被锁定。"
+`),
+	}, zei18n.WithNamespace("billing")))
+
+	// The error as received from the billing service, which has an ID conflicting with another catalog.
+	body := []byte(`{
+		"id": "account_locked",
+		"http_code": 403,
+		"grpc_code": 7,
+		"message": "Billing account is locked.",
+		"arguments": {},
+		"causes": []
+	}`)
+
+	var zedErr zeerr.Error
+	require.NoError(t, json.Unmarshal(body, &zedErr))
+
+	ctx := zeerr.ContextWithLocale(context.Background(), language.Chinese)
+	localized := zedErr.WithLocalizer(loc.Namespace("billing")).LocalizeContext(ctx)
+
+	assert.Equal(t, "账单帐户已被锁定。", localized.Message())
+}
@@ -0,0 +1,21 @@
+package zei18n
+
+// ErrorDefinition describes an error declared in the specification.
+// NOTE: it's meant to be used only by the generated code.
+type ErrorDefinition struct {
+	// ID is the error ID.
+	ID string
+	// Arguments are the arguments declared for the error.
+	Arguments []ArgumentDefinition
+	// PluralArgument is the name of the int argument used to choose the plural form of the message.
+	// It's empty if the message has no plural forms.
+	PluralArgument string
+}
+
+// ArgumentDefinition describes an error argument declared in the specification.
+type ArgumentDefinition struct {
+	// Name is the argument name.
+	Name string
+	// Format is the format of the argument value in localized messages.
+	Format ArgumentFormat
+}
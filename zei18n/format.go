@@ -0,0 +1,274 @@
+package zei18n
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// ArgumentFormat is the format of an argument value in localized messages.
+type ArgumentFormat string
+
+const (
+	// ArgumentFormatDefault formats timestamps as ArgumentFormatDateTime and floats as ArgumentFormatNumber.
+	// Integers are left as is, as they are often identifiers or codes.
+	ArgumentFormatDefault ArgumentFormat = ""
+	// ArgumentFormatDate formats timestamps as a date.
+	ArgumentFormatDate ArgumentFormat = "date"
+	// ArgumentFormatTime formats timestamps as a time of day with the time zone.
+	ArgumentFormatTime ArgumentFormat = "time"
+	// ArgumentFormatDateTime formats timestamps as a date and a time of day with the time zone.
+	ArgumentFormatDateTime ArgumentFormat = "datetime"
+	// ArgumentFormatRelative formats timestamps relative to the current time, e.g. `in 5 minutes`.
+	ArgumentFormatRelative ArgumentFormat = "relative"
+	// ArgumentFormatNumber formats numbers with the locale digit grouping and decimal separator.
+	ArgumentFormatNumber ArgumentFormat = "number"
+	// ArgumentFormatRaw leaves the value as is.
+	ArgumentFormatRaw ArgumentFormat = "raw"
+)
+
+// dateLayouts holds time.Format layouts for a language.
+type dateLayouts struct {
+	date     string
+	time     string
+	dateTime string
+}
+
+// dateLayoutsByLang is keyed by the base language.
+var dateLayoutsByLang = map[string]dateLayouts{
+	"en": {date: "Jan 2, 2006", time: "3:04 PM MST", dateTime: "Jan 2, 2006, 3:04 PM MST"},
+	"de": {date: "02.01.2006", time: "15:04 MST", dateTime: "02.01.2006, 15:04 MST"},
+	"fr": {date: "02/01/2006", time: "15:04 MST", dateTime: "02/01/2006 15:04 MST"},
+	"es": {date: "02/01/2006", time: "15:04 MST", dateTime: "02/01/2006, 15:04 MST"},
+	"ru": {date: "02.01.2006", time: "15:04 MST", dateTime: "02.01.2006, 15:04 MST"},
+	"zh": {date: "2006年1月2日", time: "15:04 MST", dateTime: "2006年1月2日 15:04 MST"},
+	"ja": {date: "2006年1月2日", time: "15:04 MST", dateTime: "2006年1月2日 15:04 MST"},
+	"ko": {date: "2006년 1월 2일", time: "15:04 MST", dateTime: "2006년 1월 2일 15:04 MST"},
+}
+
+var defaultDateLayouts = dateLayouts{
+	date:     "2006-01-02",
+	time:     "15:04 MST",
+	dateTime: "2006-01-02 15:04 MST",
+}
+
+// relativeTime holds the phrases for the relative time formatting in a language.
+// Units are keyed by the plural form of the amount.
+type relativeTime struct {
+	past    string
+	future  string
+	seconds map[plural.Form]string
+	minutes map[plural.Form]string
+	hours   map[plural.Form]string
+	days    map[plural.Form]string
+}
+
+// relativeTimeByLang is keyed by the base language.
+var relativeTimeByLang = map[string]relativeTime{
+	"en": {
+		past:    "%s ago",
+		future:  "in %s",
+		seconds: map[plural.Form]string{plural.One: "%d second", plural.Other: "%d seconds"},
+		minutes: map[plural.Form]string{plural.One: "%d minute", plural.Other: "%d minutes"},
+		hours:   map[plural.Form]string{plural.One: "%d hour", plural.Other: "%d hours"},
+		days:    map[plural.Form]string{plural.One: "%d day", plural.Other: "%d days"},
+	},
+	"de": {
+		past:    "vor %s",
+		future:  "in %s",
+		seconds: map[plural.Form]string{plural.One: "%d Sekunde", plural.Other: "%d Sekunden"},
+		minutes: map[plural.Form]string{plural.One: "%d Minute", plural.Other: "%d Minuten"},
+		hours:   map[plural.Form]string{plural.One: "%d Stunde", plural.Other: "%d Stunden"},
+		days:    map[plural.Form]string{plural.One: "%d Tag", plural.Other: "%d Tagen"},
+	},
+	"fr": {
+		past:    "il y a %s",
+		future:  "dans %s",
+		seconds: map[plural.Form]string{plural.One: "%d seconde", plural.Other: "%d secondes"},
+		minutes: map[plural.Form]string{plural.One: "%d minute", plural.Other: "%d minutes"},
+		hours:   map[plural.Form]string{plural.One: "%d heure", plural.Other: "%d heures"},
+		days:    map[plural.Form]string{plural.One: "%d jour", plural.Other: "%d jours"},
+	},
+	"es": {
+		past:    "hace %s",
+		future:  "dentro de %s",
+		seconds: map[plural.Form]string{plural.One: "%d segundo", plural.Other: "%d segundos"},
+		minutes: map[plural.Form]string{plural.One: "%d minuto", plural.Other: "%d minutos"},
+		hours:   map[plural.Form]string{plural.One: "%d hora", plural.Other: "%d horas"},
+		days:    map[plural.Form]string{plural.One: "%d día", plural.Other: "%d días"},
+	},
+	"ru": {
+		past:    "%s назад",
+		future:  "через %s",
+		seconds: map[plural.Form]string{plural.One: "%d секунду", plural.Few: "%d секунды", plural.Other: "%d секунд"},
+		minutes: map[plural.Form]string{plural.One: "%d минуту", plural.Few: "%d минуты", plural.Other: "%d минут"},
+		hours:   map[plural.Form]string{plural.One: "%d час", plural.Few: "%d часа", plural.Other: "%d часов"},
+		days:    map[plural.Form]string{plural.One: "%d день", plural.Few: "%d дня", plural.Other: "%d дней"},
+	},
+	"zh": {
+		past:    "%s前",
+		future:  "%s后",
+		seconds: map[plural.Form]string{plural.Other: "%d秒"},
+		minutes: map[plural.Form]string{plural.Other: "%d分钟"},
+		hours:   map[plural.Form]string{plural.Other: "%d小时"},
+		days:    map[plural.Form]string{plural.Other: "%d天"},
+	},
+	"ja": {
+		past:    "%s前",
+		future:  "%s後",
+		seconds: map[plural.Form]string{plural.Other: "%d秒"},
+		minutes: map[plural.Form]string{plural.Other: "%d分"},
+		hours:   map[plural.Form]string{plural.Other: "%d時間"},
+		days:    map[plural.Form]string{plural.Other: "%d日"},
+	},
+	"ko": {
+		past:    "%s 전",
+		future:  "%s 후",
+		seconds: map[plural.Form]string{plural.Other: "%d초"},
+		minutes: map[plural.Form]string{plural.Other: "%d분"},
+		hours:   map[plural.Form]string{plural.Other: "%d시간"},
+		days:    map[plural.Form]string{plural.Other: "%d일"},
+	},
+}
+
+// argumentFormatter formats argument values for a language.
+type argumentFormatter struct {
+	lang    language.Tag
+	printer *message.Printer
+	layouts dateLayouts
+	// relative is nil if the language has no relative time phrases.
+	relative *relativeTime
+	now      func() time.Time
+}
+
+func newArgumentFormatter(lang language.Tag, now func() time.Time) *argumentFormatter {
+	base, _ := lang.Base()
+
+	layouts, ok := dateLayoutsByLang[base.String()]
+	if !ok {
+		layouts = defaultDateLayouts
+	}
+
+	var relative *relativeTime
+	if phrases, ok := relativeTimeByLang[base.String()]; ok {
+		relative = &phrases
+	}
+
+	return &argumentFormatter{
+		lang:     lang,
+		printer:  message.NewPrinter(lang),
+		layouts:  layouts,
+		relative: relative,
+		now:      now,
+	}
+}
+
+// newArgumentFormats returns the formats of the defined arguments keyed by the error ID and the argument name.
+func newArgumentFormats(definitions map[string]ErrorDefinition) map[string]map[string]ArgumentFormat {
+	formats := make(map[string]map[string]ArgumentFormat, len(definitions))
+
+	for id, def := range definitions {
+		formats[id] = make(map[string]ArgumentFormat, len(def.Arguments))
+		for _, arg := range def.Arguments {
+			formats[id][arg.Name] = arg.Format
+		}
+	}
+
+	return formats
+}
+
+// formatArguments returns a copy of the arguments with the values formatted according to the formats
+// keyed by the argument name. Values of the types that have no locale-specific format are left as is.
+func (f *argumentFormatter) formatArguments(formats map[string]ArgumentFormat, args map[string]any) map[string]any {
+	if len(args) == 0 {
+		return args
+	}
+
+	formatted := make(map[string]any, len(args))
+
+	for k, v := range args {
+		formatted[k] = f.format(v, formats[k])
+	}
+
+	return formatted
+}
+
+func (f *argumentFormatter) format(val any, format ArgumentFormat) any {
+	if format == ArgumentFormatRaw {
+		return val
+	}
+
+	switch v := val.(type) {
+	case time.Time:
+		return f.formatTime(v, format)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		if format != ArgumentFormatNumber {
+			return val
+		}
+
+		return f.printer.Sprint(number.Decimal(v))
+	case float32, float64:
+		return f.printer.Sprint(number.Decimal(v))
+	default:
+		return val
+	}
+}
+
+func (f *argumentFormatter) formatTime(t time.Time, format ArgumentFormat) string {
+	switch format {
+	case ArgumentFormatDate:
+		return t.Format(f.layouts.date)
+	case ArgumentFormatTime:
+		return t.Format(f.layouts.time)
+	case ArgumentFormatRelative:
+		// The languages without the phrases get the absolute time rather than the phrases of another language.
+		if f.relative == nil {
+			return t.Format(f.layouts.dateTime)
+		}
+
+		return f.formatRelativeTime(t)
+	case ArgumentFormatDefault, ArgumentFormatDateTime, ArgumentFormatNumber, ArgumentFormatRaw:
+		fallthrough
+	default:
+		return t.Format(f.layouts.dateTime)
+	}
+}
+
+func (f *argumentFormatter) formatRelativeTime(t time.Time) string {
+	diff := t.Sub(f.now())
+
+	phrase := f.relative.future
+	if diff < 0 {
+		phrase = f.relative.past
+		diff = -diff
+	}
+
+	var (
+		units  map[plural.Form]string
+		amount int
+	)
+
+	switch {
+	case diff < time.Minute:
+		units, amount = f.relative.seconds, int(diff/time.Second)
+	case diff < time.Hour:
+		units, amount = f.relative.minutes, int(diff/time.Minute)
+	case diff < 24*time.Hour:
+		units, amount = f.relative.hours, int(diff/time.Hour)
+	default:
+		units, amount = f.relative.days, int(diff/(24*time.Hour))
+	}
+
+	form := plural.Cardinal.MatchPlural(f.lang, amount, 0, 0, 0, 0)
+
+	unit, ok := units[form]
+	if !ok {
+		unit = units[plural.Other]
+	}
+
+	return fmt.Sprintf(phrase, fmt.Sprintf(unit, amount))
+}
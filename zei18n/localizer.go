@@ -1,75 +1,297 @@
 package zei18n
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
-	"github.com/BurntSushi/toml"
-	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"golang.org/x/text/language"
 
 	"github.com/amanbolat/zederr/zeerr"
 )
 
+var errMessageNotFound = errors.New("message not found")
+
 type localizer struct {
-	localizers  map[language.Tag]*i18n.Localizer
-	defaultLang language.Tag
+	cfg *config
+	// entries holds the compiled entries keyed by the language and the entry ID.
+	entries map[language.Tag]map[string]*messageTemplate
+	// messages holds the compiled error messages keyed by the language and the error ID.
+	messages   map[language.Tag]map[string]*messageTemplate
+	formatters map[language.Tag]*argumentFormatter
+	// argumentFormats holds the formats of the defined arguments keyed by the error ID and the argument name.
+	argumentFormats map[string]map[string]ArgumentFormat
+	defaultLang     language.Tag
+	// langs holds all the supported languages, the default one is always the first.
+	langs   []language.Tag
+	matcher language.Matcher
+	// fallbackLangs holds the supported languages matched to the fallback locales.
+	fallbackLangs []language.Tag
 }
 
 // NewLocalizer creates a new localizer.
 // NOTE: it's meant to be used only by the generated code.
-func NewLocalizer(defaultLocale string, messagesMap map[string][]byte) (zeerr.Localizer, error) {
+func NewLocalizer(defaultLocale string, messagesMap map[string][]byte, opts ...Option) (zeerr.Localizer, error) {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	err := validateCatalog(cfg, messagesMap)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := newLocalizer(cfg, defaultLocale, messagesMap, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return loc, nil
+}
+
+// newLocalizer creates a localizer from the messages and the overrides of them.
+// The overrides are keyed by the language and take precedence over the messages.
+func newLocalizer(cfg *config, defaultLocale string, messagesMap, overridesMap map[string][]byte) (*localizer, error) {
 	defaultLocaleTag, err := language.Parse(defaultLocale)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse default locale [%s]: %w", defaultLocale, err)
 	}
 
 	loc := localizer{
+		cfg:         cfg,
 		defaultLang: defaultLocaleTag,
-		localizers:  map[language.Tag]*i18n.Localizer{},
+		entries:     map[language.Tag]map[string]*messageTemplate{},
+		messages:    map[language.Tag]map[string]*messageTemplate{},
+		formatters:  map[language.Tag]*argumentFormatter{},
+		// Built once, so the arguments are formatted without allocating the lookup on each call.
+		argumentFormats: newArgumentFormats(cfg.definitions),
+		langs:           []language.Tag{defaultLocaleTag},
+		matcher:         nil,
+		// Set after the supported languages are known.
+		fallbackLangs: nil,
 	}
 
-	bundle := i18n.NewBundle(defaultLocaleTag)
-	bundle.RegisterUnmarshalFunc("toml", toml.Unmarshal)
-
 	var defaultLangFound bool
 
-	for lang, data := range messagesMap {
+	addMessages := func(lang string, data []byte) error {
 		langTag, err := language.Parse(lang)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse language tag from string [%s]: %w", lang, err)
+			return fmt.Errorf("failed to parse language tag from string [%s]: %w", lang, err)
+		}
+
+		file, err := parseMessageFile(lang, data)
+		if err != nil {
+			return err
 		}
 
-		if langTag == defaultLocaleTag {
-			defaultLangFound = true
+		entries, ok := loc.entries[langTag]
+		if !ok {
+			if langTag == defaultLocaleTag {
+				defaultLangFound = true
+			} else {
+				loc.langs = append(loc.langs, langTag)
+			}
+
+			entries = map[string]*messageTemplate{}
+			loc.entries[langTag] = entries
+			loc.messages[langTag] = map[string]*messageTemplate{}
+			loc.formatters[langTag] = newArgumentFormatter(langTag, cfg.now)
 		}
 
-		bundlePath := fmt.Sprintf("%s.toml", lang)
-		bundle.MustParseMessageFileBytes(data, bundlePath)
+		for _, msg := range file.Messages {
+			mt := newMessageTemplate(langTag, msg)
+			entries[msg.ID] = mt
 
-		loc.localizers[langTag] = i18n.NewLocalizer(bundle, langTag.String())
+			if errorID, ok := strings.CutSuffix(msg.ID, "_message"); ok {
+				loc.messages[langTag][errorID] = mt
+			}
+		}
+
+		return nil
+	}
+
+	for lang, data := range messagesMap {
+		if err := addMessages(lang, data); err != nil {
+			return nil, err
+		}
+	}
+
+	// The messages parsed later replace the ones with the same ID.
+	for lang, data := range overridesMap {
+		if err := addMessages(lang, data); err != nil {
+			return nil, err
+		}
 	}
 
 	if !defaultLangFound {
 		return nil, fmt.Errorf("bundle has no messages for default locale [%s]", defaultLocaleTag)
 	}
 
+	// Sort for the deterministic matching of the equally close languages.
+	slices.SortFunc(loc.langs[1:], func(a, b language.Tag) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	loc.matcher = language.NewMatcher(loc.langs)
+
+	for _, fallback := range cfg.fallbackLocales {
+		loc.fallbackLangs = loc.appendMatched(loc.fallbackLangs, fallback)
+	}
+
 	return &loc, nil
 }
 
+// MatchLanguage returns the supported language closest to the preferred ones.
+// The default language is returned if none of them matches.
+func (l *localizer) MatchLanguage(prefs ...language.Tag) language.Tag {
+	// The most preferred language is often supported as is, e.g. when the error is localized again.
+	if len(prefs) > 0 {
+		if _, ok := l.messages[prefs[0]]; ok {
+			return prefs[0]
+		}
+	}
+
+	_, idx, confidence := l.matcher.Match(prefs...)
+	if confidence == language.No {
+		return l.defaultLang
+	}
+
+	return l.langs[idx]
+}
+
 // LocalizeMessage localizes error's public message.
+// The message is localized in the supported language closest to the requested one.
+// Timestamp and number arguments are formatted according to the language conventions,
+// and the plural argument, if any, is used to choose the plural form.
+//
+// If the message can't be rendered in the requested language, the fallback languages
+// and then the default one are tried. The error ID is returned if all of them fail.
+// Each failed attempt is passed to the MissingTranslationHandler.
 func (l *localizer) LocalizeMessage(id string, lang language.Tag, args map[string]any) string {
-	loc, ok := l.localizers[lang]
-	if !ok {
-		loc = l.localizers[l.defaultLang]
+	pluralCount := l.pluralCount(id, args)
+
+	var buf [4]language.Tag
+
+	for _, candidate := range l.fallbackChain(buf[:0], lang) {
+		mt := l.messages[candidate][id]
+
+		var data map[string]any
+		if mt != nil {
+			// The arguments are formatted only for the languages having the message.
+			data = l.formatters[candidate].formatArguments(l.argumentFormats[id], args)
+		}
+
+		msg, err := render(mt, pluralCount, data)
+		if err == nil {
+			return msg
+		}
+
+		if l.cfg.missingTranslationHandler != nil {
+			l.cfg.missingTranslationHandler(id, candidate, err)
+		}
 	}
 
-	msg, err := loc.Localize(&i18n.LocalizeConfig{
-		MessageID:    id + "_message",
-		TemplateData: args,
-	})
-	if err != nil {
-		return ""
+	return id
+}
+
+// HasMessage reports whether the error has a message in any of the supported languages.
+func (l *localizer) HasMessage(id string) bool {
+	for _, messages := range l.messages {
+		if _, ok := messages[id]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LocalizeDescription localizes error's description.
+func (l *localizer) LocalizeDescription(id string, lang language.Tag) string {
+	return l.localizeText(id+"_description", lang)
+}
+
+// LocalizeArgumentDescription localizes the description of error's argument.
+func (l *localizer) LocalizeArgumentDescription(id, arg string, lang language.Tag) string {
+	return l.localizeText(id+"_argument_"+arg, lang)
+}
+
+// localizeText localizes the entry without template data in the supported language closest to the requested one.
+// The same fallback languages as for the messages are used if the translation is missing.
+func (l *localizer) localizeText(entryID string, lang language.Tag) string {
+	var buf [4]language.Tag
+
+	for _, candidate := range l.fallbackChain(buf[:0], lang) {
+		msg, err := render(l.entries[candidate][entryID], nil, nil)
+		if err == nil {
+			return msg
+		}
+	}
+
+	return ""
+}
+
+// render renders the message, which is nil if the language has no translation for it.
+func render(msg *messageTemplate, pluralCount any, data map[string]any) (string, error) {
+	if msg == nil {
+		return "", errMessageNotFound
 	}
 
-	return msg
+	return msg.render(pluralCount, data)
+}
+
+// fallbackChain appends the supported languages to localize in to the buffer, ordered by priority:
+// the closest to the requested one, the fallback ones and the default one.
+func (l *localizer) fallbackChain(buf []language.Tag, lang language.Tag) []language.Tag {
+	chain := l.appendMatched(buf, lang)
+
+	for _, fallback := range l.fallbackLangs {
+		if !slices.Contains(chain, fallback) {
+			chain = append(chain, fallback)
+		}
+	}
+
+	if !slices.Contains(chain, l.defaultLang) {
+		chain = append(chain, l.defaultLang)
+	}
+
+	return chain
+}
+
+// appendMatched appends the supported language closest to the requested one, if there is any.
+func (l *localizer) appendMatched(chain []language.Tag, lang language.Tag) []language.Tag {
+	if _, ok := l.messages[lang]; ok {
+		if !slices.Contains(chain, lang) {
+			chain = append(chain, lang)
+		}
+
+		return chain
+	}
+
+	_, idx, confidence := l.matcher.Match(lang)
+	if confidence != language.No && !slices.Contains(chain, l.langs[idx]) {
+		chain = append(chain, l.langs[idx])
+	}
+
+	return chain
+}
+
+// pluralCount returns the value of the error's plural argument.
+func (l *localizer) pluralCount(id string, args map[string]any) any {
+	def, ok := l.cfg.definitions[id]
+	if !ok || def.PluralArgument == "" {
+		return nil
+	}
+
+	switch v := args[def.PluralArgument].(type) {
+	case int, int8, int16, int32, int64, string:
+		return v
+	case float64:
+		// Numbers are decoded as float64 from JSON or structpb.
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return nil
+	}
 }
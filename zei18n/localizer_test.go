@@ -0,0 +1,227 @@
+package zei18n_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/amanbolat/zederr/zeerr"
+	"github.com/amanbolat/zederr/zei18n"
+)
+
+var testMessages = map[string][]byte{
+	"en": []byte(`
+[account_locked_message]
+other = "Your account is locked after {{ .failed_attempts }} attempts."
+
+[account_locked_description]
+other = "Account is locked due to too many failed login attempts."
+
+[account_locked_argument_failed_attempts]
+other = "Number of failed login attempts"
+`),
+	"zh": []byte(`
+[account_locked_message]
+other = "您的帐户已被锁定({{ .failed_attempts }})。"
+`),
+	"de": []byte(`
+[account_locked_message]
+other = "Ihr Konto ist nach {{ .failed_attempts }} Versuchen gesperrt."
+`),
+}
+
+func TestLocalizer_LocalizeMessage(t *testing.T) {
+	loc, err := zei18n.NewLocalizer("en", testMessages)
+	require.NoError(t, err)
+
+	args := map[string]any{"failed_attempts": 3}
+
+	tests := []struct {
+		lang     language.Tag
+		expected string
+	}{
+		{lang: language.English, expected: "Your account is locked after 3 attempts."},
+		{lang: language.BritishEnglish, expected: "Your account is locked after 3 attempts."},
+		{lang: language.MustParse("zh-Hans-CN"), expected: "您的帐户已被锁定(3)。"},
+		{lang: language.MustParse("de-AT"), expected: "Ihr Konto ist nach 3 Versuchen gesperrt."},
+		{lang: language.Japanese, expected: "Your account is locked after 3 attempts."},
+		{lang: language.Und, expected: "Your account is locked after 3 attempts."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, loc.LocalizeMessage("account_locked", tt.lang, args))
+		})
+	}
+}
+
+func TestLocalizer_MatchLanguage(t *testing.T) {
+	loc, err := zei18n.NewLocalizer("en", testMessages)
+	require.NoError(t, err)
+
+	matcher, ok := loc.(zeerr.LanguageMatcher)
+	require.True(t, ok)
+
+	prefs := zeerr.ParseAcceptLanguage("ja-JP, zh-CN;q=0.9, en;q=0.5")
+	assert.Equal(t, language.Chinese, matcher.MatchLanguage(prefs...))
+	assert.Equal(t, language.English, matcher.MatchLanguage())
+}
+
+func TestLocalizer_LocalizeMessage_Plural(t *testing.T) {
+	loc, err := zei18n.NewLocalizer("en", map[string][]byte{
+		"en": []byte(`
+[account_locked_message]
+one = "Locked after {{ .failed_attempts }} attempt."
+other = "Locked after {{ .failed_attempts }} attempts."
+`),
+		"ru": []byte(`
+[account_locked_message]
+one = "Заблокирован после {{ .failed_attempts }} попытки."
+few = "Заблокирован после {{ .failed_attempts }} попыток (few)."
+many = "Заблокирован после {{ .failed_attempts }} попыток (many)."
+other = "Заблокирован после {{ .failed_attempts }} попыток (other)."
+`),
+	}, zei18n.WithErrorDefinitions(zei18n.ErrorDefinition{
+		ID:             "account_locked",
+		Arguments:      []zei18n.ArgumentDefinition{{Name: "failed_attempts"}},
+		PluralArgument: "failed_attempts",
+	}))
+	require.NoError(t, err)
+
+	tests := []struct {
+		lang     language.Tag
+		count    any
+		expected string
+	}{
+		{lang: language.English, count: 1, expected: "Locked after 1 attempt."},
+		{lang: language.English, count: 5, expected: "Locked after 5 attempts."},
+		{lang: language.English, count: float64(1), expected: "Locked after 1 attempt."},
+		{lang: language.Russian, count: 21, expected: "Заблокирован после 21 попытки."},
+		{lang: language.Russian, count: 3, expected: "Заблокирован после 3 попыток (few)."},
+		{lang: language.Russian, count: 11, expected: "Заблокирован после 11 попыток (many)."},
+	}
+
+	for _, tt := range tests {
+		msg := loc.LocalizeMessage("account_locked", tt.lang, map[string]any{"failed_attempts": tt.count})
+		assert.Equal(t, tt.expected, msg)
+	}
+}
+
+func TestLocalizer_LocalizeMessage_ArgumentFormat(t *testing.T) {
+	now := time.Date(2024, 6, 26, 0, 36, 6, 0, time.UTC)
+
+	loc, err := zei18n.NewLocalizer("en", map[string][]byte{
+		"en": []byte(`
+[account_locked_message]
+other = "{{ .failed_attempts }} attempts, {{ .amount }}, {{ .user_id }}, {{ .unlock_date }}, {{ .unlock_time }}, {{ .unlock_in }}."
+`),
+		"de": []byte(`
+[account_locked_message]
+other = "{{ .failed_attempts }} Versuche, {{ .amount }}, {{ .user_id }}, {{ .unlock_date }}, {{ .unlock_time }}, {{ .unlock_in }}."
+`),
+		"ko": []byte(`
+[account_locked_message]
+other = "{{ .failed_attempts }}, {{ .user_id }}, {{ .unlock_in }}."
+`),
+		"it": []byte(`
+[account_locked_message]
+other = "{{ .failed_attempts }}, {{ .user_id }}, {{ .unlock_in }}."
+`),
+	}, zei18n.WithClock(func() time.Time { return now }), zei18n.WithErrorDefinitions(zei18n.ErrorDefinition{
+		ID: "account_locked",
+		Arguments: []zei18n.ArgumentDefinition{
+			{Name: "failed_attempts", Format: zei18n.ArgumentFormatNumber},
+			{Name: "amount"},
+			{Name: "user_id"},
+			{Name: "unlock_date", Format: zei18n.ArgumentFormatDate},
+			{Name: "unlock_time"},
+			{Name: "unlock_in", Format: zei18n.ArgumentFormatRelative},
+		},
+		PluralArgument: "",
+	}))
+	require.NoError(t, err)
+
+	args := map[string]any{
+		"failed_attempts": 1234,
+		"amount":          1234.5,
+		"user_id":         12345,
+		"unlock_date":     now,
+		"unlock_time":     now,
+		"unlock_in":       now.Add(5 * time.Minute),
+	}
+
+	assert.Equal(t,
+		"1,234 attempts, 1,234.5, 12345, Jun 26, 2024, Jun 26, 2024, 12:36 AM UTC, in 5 minutes.",
+		loc.LocalizeMessage("account_locked", language.English, args),
+	)
+	assert.Equal(t,
+		"1.234 Versuche, 1.234,5, 12345, 26.06.2024, 26.06.2024, 00:36 UTC, in 5 Minuten.",
+		loc.LocalizeMessage("account_locked", language.German, args),
+	)
+	assert.Equal(t, "1,234, 12345, 5분 후.", loc.LocalizeMessage("account_locked", language.Korean, args))
+	// The languages without the relative time phrases get the absolute time.
+	assert.Equal(t, "1.234, 12345, 2024-06-26 00:41 UTC.", loc.LocalizeMessage("account_locked", language.Italian, args))
+}
+
+func TestLocalizer_LocalizeDescription(t *testing.T) {
+	loc, err := zei18n.NewLocalizer("en", testMessages)
+	require.NoError(t, err)
+
+	descLoc, ok := loc.(zeerr.DescriptionLocalizer)
+	require.True(t, ok)
+
+	assert.Equal(t, "Account is locked due to too many failed login attempts.", descLoc.LocalizeDescription("account_locked", language.German))
+	assert.Equal(t, "Number of failed login attempts", descLoc.LocalizeArgumentDescription("account_locked", "failed_attempts", language.English))
+	assert.Empty(t, descLoc.LocalizeArgumentDescription("account_locked", "unknown", language.English))
+}
+
+func TestLocalizer_LocalizeMessage_Fallback(t *testing.T) {
+	type miss struct {
+		id   string
+		lang language.Tag
+	}
+
+	var misses []miss
+
+	loc, err := zei18n.NewLocalizer("en", map[string][]byte{
+		"en": []byte(`
+[account_locked_message]
+other = "Your account is locked after {{ .failed_attempts }} attempts."
+
+[user_not_found_message]
+other = "User not found."
+`),
+		"de": []byte(`
+[account_locked_message]
+other = "Ihr Konto ist nach {{ .failed_attempts }} Versuchen gesperrt."
+`),
+		"fr": []byte(`
+[account_locked_message]
+other = "Votre compte est verrouillé après {{ index .failed_attempts 1 }} tentatives."
+`),
+	},
+		zei18n.WithFallbackLocales(language.German),
+		zei18n.WithMissingTranslationHandler(func(id string, lang language.Tag, err error) {
+			assert.Error(t, err)
+			misses = append(misses, miss{id: id, lang: lang})
+		}),
+	)
+	require.NoError(t, err)
+
+	args := map[string]any{"failed_attempts": 3}
+
+	assert.Equal(t, "Ihr Konto ist nach 3 Versuchen gesperrt.", loc.LocalizeMessage("account_locked", language.French, args))
+	assert.Equal(t, "User not found.", loc.LocalizeMessage("user_not_found", language.French, nil))
+	assert.Equal(t, "unknown", loc.LocalizeMessage("unknown", language.English, nil))
+
+	assert.Equal(t, []miss{
+		{id: "account_locked", lang: language.French},
+		{id: "user_not_found", lang: language.French},
+		{id: "user_not_found", lang: language.German},
+		{id: "unknown", lang: language.English},
+		{id: "unknown", lang: language.German},
+	}, misses)
+}
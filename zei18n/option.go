@@ -0,0 +1,88 @@
+package zei18n
+
+import (
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+type config struct {
+	definitions               map[string]ErrorDefinition
+	now                       func() time.Time
+	validationMode            ValidationMode
+	validationReporter        func(*CatalogError)
+	fallbackLocales           []language.Tag
+	missingTranslationHandler MissingTranslationHandler
+	namespace                 string
+}
+
+func defaultConfig() *config {
+	return &config{
+		definitions:               map[string]ErrorDefinition{},
+		now:                       time.Now,
+		validationMode:            ValidationDisabled,
+		validationReporter:        nil,
+		fallbackLocales:           nil,
+		missingTranslationHandler: nil,
+		namespace:                 "",
+	}
+}
+
+// MissingTranslationHandler is called when the message of the error can't be rendered in the language,
+// e.g. the translation is missing or its template fails.
+type MissingTranslationHandler func(id string, lang language.Tag, err error)
+
+// Option configures the localizer.
+type Option func(*config)
+
+// WithErrorDefinitions provides the definitions of the errors declared in the specification.
+// NOTE: it's meant to be used only by the generated code.
+func WithErrorDefinitions(definitions ...ErrorDefinition) Option {
+	return func(c *config) {
+		for _, def := range definitions {
+			c.definitions[def.ID] = def
+		}
+	}
+}
+
+// WithClock sets the function returning the current time,
+// which is used to format timestamps relative to it.
+func WithClock(now func() time.Time) Option {
+	return func(c *config) {
+		c.now = now
+	}
+}
+
+// WithValidation enables the validation of the translations of each locale against the error definitions.
+// The translations are checked for missing and extra message IDs, unknown arguments and invalid templates.
+// The gaps are passed to the reporter, if it's not nil, and in strict mode they also fail the localizer creation.
+func WithValidation(mode ValidationMode, reporter func(*CatalogError)) Option {
+	return func(c *config) {
+		c.validationMode = mode
+		c.validationReporter = reporter
+	}
+}
+
+// WithFallbackLocales sets the locales tried in order when the message can't be rendered in the requested locale.
+// The default locale is always tried last.
+func WithFallbackLocales(locales ...language.Tag) Option {
+	return func(c *config) {
+		c.fallbackLocales = locales
+	}
+}
+
+// WithMissingTranslationHandler sets the handler called for each locale the message can't be rendered in.
+func WithMissingTranslationHandler(handler MissingTranslationHandler) Option {
+	return func(c *config) {
+		c.missingTranslationHandler = handler
+	}
+}
+
+// WithNamespace registers the errors of the catalog in CompositeLocalizer with IDs prefixed by the namespace
+// and NamespaceSeparator, e.g. `billing/account_locked`, so catalogs with conflicting IDs can be registered.
+// It's ignored by other localizers.
+func WithNamespace(namespace string) Option {
+	return func(c *config) {
+		c.namespace = namespace
+	}
+}
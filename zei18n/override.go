@@ -0,0 +1,191 @@
+package zei18n
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+const overrideFilePrefix = "locale."
+
+// OverrideLocalizer is a localizer with the embedded messages overridden by the translations
+// loaded at runtime, which can be reloaded without restarting the application.
+//
+// The overrides are read from the files named `locale.<lang>.toml` in the root of the file system,
+// the same way the generator names them. Each file may contain only the messages it overrides.
+// It is safe for concurrent use.
+type OverrideLocalizer struct {
+	cfg           *config
+	defaultLocale string
+	messagesMap   map[string][]byte
+	// catalog holds the IDs of the embedded messages.
+	catalog   map[string]struct{}
+	overrides fs.FS
+	current   atomic.Pointer[localizer]
+}
+
+// NewOverrideLocalizer creates a new localizer and loads the overrides from the file system.
+func NewOverrideLocalizer(
+	defaultLocale string,
+	messagesMap map[string][]byte,
+	overrides fs.FS,
+	opts ...Option,
+) (*OverrideLocalizer, error) {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	err := validateCatalog(cfg, messagesMap)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := map[string]struct{}{}
+
+	for lang, data := range messagesMap {
+		file, err := parseMessageFile(lang, data)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, msg := range file.Messages {
+			catalog[msg.ID] = struct{}{}
+		}
+	}
+
+	l := &OverrideLocalizer{
+		cfg:           cfg,
+		defaultLocale: defaultLocale,
+		messagesMap:   messagesMap,
+		catalog:       catalog,
+		overrides:     overrides,
+	}
+
+	err = l.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Reload reads the overrides again and atomically replaces the messages.
+// If the overrides are invalid, an error is returned and the previously loaded messages are kept.
+// Messages with IDs that don't exist in the embedded messages, templates that can't be parsed
+// and, if the error definitions are provided, templates referencing unknown arguments are considered invalid,
+// the latter two are reported as CatalogError.
+func (l *OverrideLocalizer) Reload() error {
+	overridesMap, err := l.readOverrides()
+	if err != nil {
+		return err
+	}
+
+	loc, err := newLocalizer(l.cfg, l.defaultLocale, l.messagesMap, overridesMap)
+	if err != nil {
+		return err
+	}
+
+	l.current.Store(loc)
+
+	return nil
+}
+
+// Watch reloads the overrides with the given interval until the context is canceled.
+// The reload errors are passed to onError, if it's not nil.
+// The interval must be positive, otherwise Watch returns immediately.
+func (l *OverrideLocalizer) Watch(ctx context.Context, interval time.Duration, onError func(error)) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := l.Reload()
+			if err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// MatchLanguage returns the supported language closest to the preferred ones.
+// The default language is returned if none of them matches.
+func (l *OverrideLocalizer) MatchLanguage(prefs ...language.Tag) language.Tag {
+	return l.current.Load().MatchLanguage(prefs...)
+}
+
+// LocalizeMessage localizes error's public message.
+func (l *OverrideLocalizer) LocalizeMessage(id string, lang language.Tag, args map[string]any) string {
+	return l.current.Load().LocalizeMessage(id, lang, args)
+}
+
+// HasMessage reports whether the error has a message in any of the supported languages.
+func (l *OverrideLocalizer) HasMessage(id string) bool {
+	return l.current.Load().HasMessage(id)
+}
+
+// LocalizeDescription localizes error's description.
+func (l *OverrideLocalizer) LocalizeDescription(id string, lang language.Tag) string {
+	return l.current.Load().LocalizeDescription(id, lang)
+}
+
+// LocalizeArgumentDescription localizes the description of error's argument.
+func (l *OverrideLocalizer) LocalizeArgumentDescription(id, arg string, lang language.Tag) string {
+	return l.current.Load().LocalizeArgumentDescription(id, arg, lang)
+}
+
+// readOverrides reads and validates the override files.
+func (l *OverrideLocalizer) readOverrides() (map[string][]byte, error) {
+	entries, err := fs.ReadDir(l.overrides, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read overrides: %w", err)
+	}
+
+	overridesMap := map[string][]byte{}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, overrideFilePrefix) || path.Ext(name) != messageFileExt {
+			continue
+		}
+
+		lang := strings.TrimSuffix(strings.TrimPrefix(name, overrideFilePrefix), messageFileExt)
+
+		data, err := fs.ReadFile(l.overrides, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read overrides file [%s]: %w", name, err)
+		}
+
+		file, err := parseMessageFile(lang, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse overrides file [%s]: %w", name, err)
+		}
+
+		for _, msg := range file.Messages {
+			if _, ok := l.catalog[msg.ID]; !ok {
+				return nil, fmt.Errorf("overrides file [%s] has unknown message [%s]", name, msg.ID)
+			}
+		}
+
+		if catalogErr := validateOverrides(l.cfg.definitions, file); !catalogErr.empty() {
+			return nil, fmt.Errorf("overrides file [%s] is invalid: %w", name, catalogErr)
+		}
+
+		overridesMap[lang] = data
+	}
+
+	return overridesMap, nil
+}
@@ -0,0 +1,104 @@
+package zei18n_test
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/amanbolat/zederr/zei18n"
+)
+
+func TestOverrideLocalizer(t *testing.T) {
+	overrides := fstest.MapFS{
+		"locale.zh.toml": &fstest.MapFile{Data: []byte(`
+[account_locked_message]
+other = "您的帐户已被锁定（{{ .failed_attempts }}次）。"
+`)},
+		"README.md": &fstest.MapFile{Data: []byte("not an override")},
+	}
+
+	loc, err := zei18n.NewOverrideLocalizer("en", testMessages, overrides)
+	require.NoError(t, err)
+
+	args := map[string]any{"failed_attempts": 3}
+
+	assert.Equal(t, "您的帐户已被锁定（3次）。", loc.LocalizeMessage("account_locked", language.Chinese, args))
+	assert.Equal(t, "Your account is locked after 3 attempts.", loc.LocalizeMessage("account_locked", language.English, args))
+
+	overrides["locale.en.toml"] = &fstest.MapFile{Data: []byte(`
+[account_locked_message]
+other = "Account locked after {{ .failed_attempts }} attempts."
+`)}
+
+	require.NoError(t, loc.Reload())
+	assert.Equal(t, "Account locked after 3 attempts.", loc.LocalizeMessage("account_locked", language.English, args))
+
+	overrides["locale.de.toml"] = &fstest.MapFile{Data: []byte(`
+[unknown_message]
+other = "Unbekannt"
+`)}
+
+	require.ErrorContains(t, loc.Reload(), "unknown message [unknown_message]")
+	assert.Equal(t, "Account locked after 3 attempts.", loc.LocalizeMessage("account_locked", language.English, args))
+
+	// Returns immediately instead of panicking on the non-positive interval.
+	loc.Watch(context.Background(), 0, nil)
+}
+
+func TestOverrideLocalizer_InvalidOverrides(t *testing.T) {
+	tests := []struct {
+		name     string
+		override string
+		check    func(t *testing.T, catalogErr *zei18n.CatalogError)
+	}{
+		{
+			name: "broken template",
+			override: `
+[account_locked_message]
+other = "Ihr Konto ist gesperrt {{ .nme "
+`,
+			check: func(t *testing.T, catalogErr *zei18n.CatalogError) {
+				t.Helper()
+				assert.Contains(t, catalogErr.InvalidTemplates, "account_locked_message")
+			},
+		},
+		{
+			name: "unknown argument",
+			override: `
+[account_locked_message]
+other = "Konto {{ .user_id }} ist gesperrt."
+`,
+			check: func(t *testing.T, catalogErr *zei18n.CatalogError) {
+				t.Helper()
+				assert.Equal(t, map[string][]string{"account_locked_message": {"user_id"}}, catalogErr.UnknownArguments)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overrides := fstest.MapFS{}
+
+			loc, err := zei18n.NewOverrideLocalizer("en", testMessages, overrides, zei18n.WithErrorDefinitions(testDefinitions...))
+			require.NoError(t, err)
+
+			overrides["locale.de.toml"] = &fstest.MapFile{Data: []byte(tt.override)}
+
+			err = loc.Reload()
+
+			var catalogErr *zei18n.CatalogError
+			require.ErrorAs(t, err, &catalogErr)
+			tt.check(t, catalogErr)
+
+			args := map[string]any{"failed_attempts": 3}
+			assert.Equal(t, "Ihr Konto ist nach 3 Versuchen gesperrt.", loc.LocalizeMessage("account_locked", language.German, args))
+
+			_, err = zei18n.NewOverrideLocalizer("en", testMessages, overrides, zei18n.WithErrorDefinitions(testDefinitions...))
+			require.ErrorAs(t, err, &catalogErr)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package zei18n
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// maxPooledBufferSize limits the size of the buffers returned to the pool,
+// so a single huge message doesn't keep the memory.
+const maxPooledBufferSize = 4 << 10
+
+var bufferPool = sync.Pool{
+	New: func() any {
+		return new(bytes.Buffer)
+	},
+}
+
+// compiledTemplate is a plural form of a message parsed once.
+type compiledTemplate struct {
+	src string
+	// tmpl is nil if the source has no actions and is rendered as is.
+	tmpl *template.Template
+	// err is the parse error, which is returned on each render.
+	err error
+}
+
+func newCompiledTemplate(id, src string) *compiledTemplate {
+	if !strings.Contains(src, "{{") {
+		return &compiledTemplate{src: src, tmpl: nil, err: nil}
+	}
+
+	tmpl, err := template.New(id).Parse(src)
+	if err != nil {
+		return &compiledTemplate{src: src, tmpl: nil, err: fmt.Errorf("failed to parse template of message [%s]: %w", id, err)}
+	}
+
+	return &compiledTemplate{src: src, tmpl: tmpl, err: nil}
+}
+
+func (t *compiledTemplate) execute(data map[string]any) (string, error) {
+	if t.err != nil {
+		return "", t.err
+	}
+
+	if t.tmpl == nil {
+		return t.src, nil
+	}
+
+	buf, _ := bufferPool.Get().(*bytes.Buffer)
+	defer func() {
+		if buf.Cap() <= maxPooledBufferSize {
+			buf.Reset()
+			bufferPool.Put(buf)
+		}
+	}()
+
+	err := t.tmpl.Execute(buf, data)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute template of message [%s]: %w", t.tmpl.Name(), err)
+	}
+
+	return buf.String(), nil
+}
+
+// messageTemplate holds the compiled plural forms of a message in a language.
+type messageTemplate struct {
+	id    string
+	lang  language.Tag
+	forms map[plural.Form]*compiledTemplate
+}
+
+func newMessageTemplate(lang language.Tag, msg *i18n.Message) *messageTemplate {
+	mt := &messageTemplate{
+		id:    msg.ID,
+		lang:  lang,
+		forms: map[plural.Form]*compiledTemplate{},
+	}
+
+	for form, src := range map[plural.Form]string{
+		plural.Zero:  msg.Zero,
+		plural.One:   msg.One,
+		plural.Two:   msg.Two,
+		plural.Few:   msg.Few,
+		plural.Many:  msg.Many,
+		plural.Other: msg.Other,
+	} {
+		if src != "" {
+			mt.forms[form] = newCompiledTemplate(msg.ID, src)
+		}
+	}
+
+	return mt
+}
+
+// render renders the plural form matching the count, or `other` form if the message has no such form.
+// The count is ignored if it's nil.
+func (mt *messageTemplate) render(count any, data map[string]any) (string, error) {
+	form := plural.Other
+
+	if count != nil {
+		var err error
+
+		form, err = mt.pluralForm(count)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	tmpl, ok := mt.forms[form]
+	if !ok {
+		tmpl, ok = mt.forms[plural.Other]
+	}
+
+	if !ok {
+		return "", fmt.Errorf("message [%s] has no plural form [%v] for locale [%s]", mt.id, form, mt.lang)
+	}
+
+	return tmpl.execute(data)
+}
+
+// pluralForm returns the plural form of the count, which is an integer or a decimal string.
+func (mt *messageTemplate) pluralForm(count any) (plural.Form, error) {
+	var s string
+
+	switch v := count.(type) {
+	case int:
+		s = strconv.Itoa(v)
+	case int8, int16, int32, int64:
+		s = fmt.Sprint(v)
+	case string:
+		s = v
+	default:
+		return plural.Other, fmt.Errorf("invalid plural count [%v] of message [%s]", count, mt.id)
+	}
+
+	// The operands are described in https://unicode.org/reports/tr35/tr35-numbers.html#Operands.
+	s = strings.TrimPrefix(s, "-")
+	intPart, fracPart, _ := strings.Cut(s, ".")
+
+	i, err := strconv.Atoi(intPart)
+	if err != nil {
+		return plural.Other, fmt.Errorf("invalid plural count [%v] of message [%s]: %w", count, mt.id, err)
+	}
+
+	var f, t int
+
+	trimmedFrac := strings.TrimRight(fracPart, "0")
+
+	if fracPart != "" {
+		f, err = strconv.Atoi(fracPart)
+		if err != nil {
+			return plural.Other, fmt.Errorf("invalid plural count [%v] of message [%s]: %w", count, mt.id, err)
+		}
+	}
+
+	if trimmedFrac != "" {
+		t, _ = strconv.Atoi(trimmedFrac)
+	}
+
+	return plural.Cardinal.MatchPlural(mt.lang, i, len(fracPart), len(trimmedFrac), f, t), nil
+}
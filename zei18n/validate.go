@@ -0,0 +1,293 @@
+package zei18n
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/BurntSushi/toml"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+const messageFileExt = ".toml"
+
+// ValidationMode controls how the gaps between the translations and the error definitions are handled.
+type ValidationMode int
+
+const (
+	// ValidationDisabled skips the validation.
+	ValidationDisabled ValidationMode = iota
+	// ValidationLenient reports the gaps without failing.
+	ValidationLenient
+	// ValidationStrict reports the gaps and fails the localizer creation.
+	ValidationStrict
+)
+
+// CatalogError describes the gaps between the translations of a locale and the error definitions.
+type CatalogError struct {
+	// Locale is the locale of the translations.
+	Locale language.Tag
+	// MissingIDs are the IDs of the messages missing for the defined errors.
+	MissingIDs []string
+	// ExtraIDs are the IDs of the messages that don't belong to any defined error or argument.
+	ExtraIDs []string
+	// UnknownArguments are the arguments referenced by the messages but not defined for the error,
+	// keyed by the message ID.
+	UnknownArguments map[string][]string
+	// InvalidTemplates are the errors of the message templates that can't be parsed, keyed by the message ID.
+	InvalidTemplates map[string]error
+}
+
+func (e *CatalogError) Error() string {
+	var problems []string
+
+	if len(e.MissingIDs) > 0 {
+		problems = append(problems, fmt.Sprintf("missing messages %v", e.MissingIDs))
+	}
+
+	if len(e.ExtraIDs) > 0 {
+		problems = append(problems, fmt.Sprintf("extra messages %v", e.ExtraIDs))
+	}
+
+	for _, id := range sortedKeys(e.UnknownArguments) {
+		problems = append(problems, fmt.Sprintf("message [%s] has unknown arguments %v", id, e.UnknownArguments[id]))
+	}
+
+	for _, id := range sortedKeys(e.InvalidTemplates) {
+		problems = append(problems, fmt.Sprintf("message [%s] has invalid template: %v", id, e.InvalidTemplates[id]))
+	}
+
+	return fmt.Sprintf("translations for locale [%s]: %s", e.Locale, strings.Join(problems, "; "))
+}
+
+func (e *CatalogError) empty() bool {
+	return len(e.MissingIDs) == 0 && len(e.ExtraIDs) == 0 && len(e.UnknownArguments) == 0 && len(e.InvalidTemplates) == 0
+}
+
+// validateCatalog validates the translations of each locale against the error definitions.
+// The gaps are passed to the reporter, and in strict mode they are also returned as an error.
+func validateCatalog(cfg *config, messagesMap map[string][]byte) error {
+	if cfg.validationMode == ValidationDisabled {
+		return nil
+	}
+
+	var errs []error
+
+	for _, lang := range sortedKeys(messagesMap) {
+		file, err := parseMessageFile(lang, messagesMap[lang])
+		if err != nil {
+			return err
+		}
+
+		catalogErr := validateMessages(cfg.definitions, file)
+		if catalogErr.empty() {
+			continue
+		}
+
+		if cfg.validationReporter != nil {
+			cfg.validationReporter(catalogErr)
+		}
+
+		errs = append(errs, catalogErr)
+	}
+
+	if cfg.validationMode == ValidationStrict {
+		return errors.Join(errs...)
+	}
+
+	return nil
+}
+
+func newCatalogError(locale language.Tag) *CatalogError {
+	return &CatalogError{
+		Locale:           locale,
+		MissingIDs:       nil,
+		ExtraIDs:         nil,
+		UnknownArguments: map[string][]string{},
+		InvalidTemplates: map[string]error{},
+	}
+}
+
+func validateMessages(definitions map[string]ErrorDefinition, file *i18n.MessageFile) *CatalogError {
+	catalogErr := newCatalogError(file.Tag)
+
+	knownIDs := knownMessageIDs(definitions)
+	foundIDs := map[string]struct{}{}
+
+	for _, msg := range file.Messages {
+		foundIDs[msg.ID] = struct{}{}
+
+		args, ok := knownIDs[msg.ID]
+		if !ok {
+			catalogErr.ExtraIDs = append(catalogErr.ExtraIDs, msg.ID)
+
+			continue
+		}
+
+		catalogErr.validateTemplates(msg, args, true)
+	}
+
+	for _, id := range sortedKeys(definitions) {
+		if _, ok := foundIDs[id+"_message"]; !ok {
+			catalogErr.MissingIDs = append(catalogErr.MissingIDs, id+"_message")
+		}
+	}
+
+	slices.Sort(catalogErr.ExtraIDs)
+
+	return catalogErr
+}
+
+// validateOverrides validates the templates of the override messages.
+// The overrides may contain only some of the messages, so the missing ones are not reported.
+// The arguments are checked only for the messages of the defined errors.
+func validateOverrides(definitions map[string]ErrorDefinition, file *i18n.MessageFile) *CatalogError {
+	catalogErr := newCatalogError(file.Tag)
+	knownIDs := knownMessageIDs(definitions)
+
+	for _, msg := range file.Messages {
+		args, ok := knownIDs[msg.ID]
+		catalogErr.validateTemplates(msg, args, ok)
+	}
+
+	return catalogErr
+}
+
+// knownMessageIDs returns the IDs of the entries of the defined errors
+// with the arguments of the messages. Other entries have no arguments.
+func knownMessageIDs(definitions map[string]ErrorDefinition) map[string]map[string]struct{} {
+	knownIDs := map[string]map[string]struct{}{}
+
+	for id, def := range definitions {
+		args := map[string]struct{}{}
+		for _, arg := range def.Arguments {
+			args[arg.Name] = struct{}{}
+			knownIDs[id+"_argument_"+arg.Name] = nil
+		}
+
+		knownIDs[id+"_message"] = args
+		knownIDs[id+"_description"] = nil
+	}
+
+	return knownIDs
+}
+
+// validateTemplates records the plural forms of the message that can't be parsed,
+// and, if checkArgs is true, the referenced arguments missing in args.
+func (e *CatalogError) validateTemplates(msg *i18n.Message, args map[string]struct{}, checkArgs bool) {
+	for _, text := range []string{msg.Zero, msg.One, msg.Two, msg.Few, msg.Many, msg.Other} {
+		if text == "" {
+			continue
+		}
+
+		refs, err := templateArguments(text)
+		if err != nil {
+			e.InvalidTemplates[msg.ID] = err
+
+			return
+		}
+
+		if !checkArgs {
+			continue
+		}
+
+		for _, ref := range refs {
+			_, ok := args[ref]
+			if !ok && !slices.Contains(e.UnknownArguments[msg.ID], ref) {
+				e.UnknownArguments[msg.ID] = append(e.UnknownArguments[msg.ID], ref)
+			}
+		}
+	}
+}
+
+// templateArguments returns the names of the template data fields referenced by the template.
+func templateArguments(text string) ([]string, error) {
+	tmpl, err := template.New("").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []string
+
+	collectTemplateArguments(tmpl.Root, &refs)
+
+	return refs, nil
+}
+
+func collectTemplateArguments(node parse.Node, refs *[]string) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+
+		for _, child := range n.Nodes {
+			collectTemplateArguments(child, refs)
+		}
+	case *parse.ActionNode:
+		collectTemplateArguments(n.Pipe, refs)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+
+		for _, cmd := range n.Cmds {
+			collectTemplateArguments(cmd, refs)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectTemplateArguments(arg, refs)
+		}
+	case *parse.ChainNode:
+		collectTemplateArguments(n.Node, refs)
+	case *parse.FieldNode:
+		*refs = append(*refs, n.Ident[0])
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			*refs = append(*refs, n.Ident[1])
+		}
+	case *parse.IfNode:
+		collectTemplateArguments(n.Pipe, refs)
+		collectTemplateArguments(n.List, refs)
+		collectTemplateArguments(n.ElseList, refs)
+	// The fields inside range and with blocks are relative to the pipeline value,
+	// so only the pipeline refers to the arguments.
+	case *parse.RangeNode:
+		collectTemplateArguments(n.Pipe, refs)
+		collectTemplateArguments(n.ElseList, refs)
+	case *parse.WithNode:
+		collectTemplateArguments(n.Pipe, refs)
+		collectTemplateArguments(n.ElseList, refs)
+	}
+}
+
+// parseMessageFile parses the messages in TOML file.
+func parseMessageFile(lang string, data []byte) (*i18n.MessageFile, error) {
+	if _, err := language.Parse(lang); err != nil {
+		return nil, fmt.Errorf("failed to parse language tag from string [%s]: %w", lang, err)
+	}
+
+	file, err := i18n.ParseMessageFileBytes(data, lang+messageFileExt, map[string]i18n.UnmarshalFunc{
+		"toml": toml.Unmarshal,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse messages for locale [%s]: %w", lang, err)
+	}
+
+	return file, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
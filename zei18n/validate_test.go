@@ -0,0 +1,71 @@
+package zei18n_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/amanbolat/zederr/zei18n"
+)
+
+var testDefinitions = []zei18n.ErrorDefinition{
+	{ID: "account_locked", Arguments: []zei18n.ArgumentDefinition{{Name: "failed_attempts"}}, PluralArgument: ""},
+	{ID: "user_not_found", Arguments: nil, PluralArgument: ""},
+}
+
+func TestNewLocalizer_Validation(t *testing.T) {
+	messages := map[string][]byte{
+		"en": []byte(`
+[account_locked_message]
+other = "Your account is locked after {{ .failed_attempts }} attempts."
+
+[account_locked_argument_failed_attempts]
+other = "Number of failed login attempts"
+
+[user_not_found_message]
+other = "User not found."
+`),
+		"de": []byte(`
+[account_locked_message]
+other = "Ihr Konto ist nach {{ .attempts }} Versuchen gesperrt."
+
+[user_deleted_message]
+other = "Benutzer gelöscht."
+`),
+	}
+
+	var reported []*zei18n.CatalogError
+
+	report := func(err *zei18n.CatalogError) {
+		reported = append(reported, err)
+	}
+
+	_, err := zei18n.NewLocalizer("en", messages,
+		zei18n.WithErrorDefinitions(testDefinitions...),
+		zei18n.WithValidation(zei18n.ValidationLenient, report),
+	)
+	require.NoError(t, err)
+	require.Len(t, reported, 1)
+
+	catalogErr := reported[0]
+	assert.Equal(t, language.German, catalogErr.Locale)
+	assert.Equal(t, []string{"user_not_found_message"}, catalogErr.MissingIDs)
+	assert.Equal(t, []string{"user_deleted_message"}, catalogErr.ExtraIDs)
+	assert.Equal(t, map[string][]string{"account_locked_message": {"attempts"}}, catalogErr.UnknownArguments)
+
+	_, err = zei18n.NewLocalizer("en", messages,
+		zei18n.WithErrorDefinitions(testDefinitions...),
+		zei18n.WithValidation(zei18n.ValidationStrict, nil),
+	)
+	require.ErrorAs(t, err, &catalogErr)
+	assert.Equal(t, language.German, catalogErr.Locale)
+}
+
+func TestNewLocalizer_InvalidMessages(t *testing.T) {
+	_, err := zei18n.NewLocalizer("en", map[string][]byte{
+		"en": []byte(`[account_locked_message`),
+	})
+	require.ErrorContains(t, err, "failed to parse messages for locale [en]")
+}
@@ -32,6 +32,10 @@ type Error struct {
 	Message   string           `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	Arguments *structpb.Struct `protobuf:"bytes,5,opt,name=arguments,proto3" json:"arguments,omitempty"`
 	Causes    []*Error         `protobuf:"bytes,6,rep,name=causes,proto3" json:"causes,omitempty"`
+	// The target the error refers to, e.g. a JSON Pointer or a dotted path of a form field.
+	Target string `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
+	// The non-localized metadata allowed to leave the service, e.g. the request ID.
+	Metadata map[string]string `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *Error) Reset() {
@@ -108,6 +112,20 @@ func (x *Error) GetCauses() []*Error {
 	return nil
 }
 
+func (x *Error) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Error) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 var File_zeproto_v1_error_proto protoreflect.FileDescriptor
 
 var file_zeproto_v1_error_proto_rawDesc = []byte{
@@ -115,7 +133,7 @@ var file_zeproto_v1_error_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x7a, 0x65, 0x64, 0x65, 0x72, 0x72,
 	0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xcc, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x6f, 0x22, 0xdd, 0x02, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67,
 	0x72, 0x70, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
 	0x67, 0x72, 0x70, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70,
@@ -128,10 +146,19 @@ var file_zeproto_v1_error_proto_rawDesc = []byte{
 	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x61, 0x75, 0x73, 0x65, 0x73,
 	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x7a, 0x65, 0x64, 0x65, 0x72, 0x72, 0x2e,
 	0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x63, 0x61, 0x75, 0x73, 0x65, 0x73,
-	0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
-	0x6d, 0x61, 0x6e, 0x62, 0x6f, 0x6c, 0x61, 0x74, 0x2f, 0x7a, 0x65, 0x64, 0x65, 0x72, 0x72, 0x2f,
-	0x7a, 0x65, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x7a, 0x65, 0x64,
-	0x65, 0x72, 0x72, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x7a, 0x65, 0x64,
+	0x65, 0x72, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6d, 0x61, 0x6e, 0x62, 0x6f, 0x6c, 0x61, 0x74, 0x2f, 0x7a, 0x65, 0x64, 0x65, 0x72, 0x72,
+	0x2f, 0x7a, 0x65, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x7a, 0x65,
+	0x64, 0x65, 0x72, 0x72, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -146,19 +173,21 @@ func file_zeproto_v1_error_proto_rawDescGZIP() []byte {
 	return file_zeproto_v1_error_proto_rawDescData
 }
 
-var file_zeproto_v1_error_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_zeproto_v1_error_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_zeproto_v1_error_proto_goTypes = []interface{}{
 	(*Error)(nil),           // 0: zederr.v1.Error
-	(*structpb.Struct)(nil), // 1: google.protobuf.Struct
+	nil,                     // 1: zederr.v1.Error.MetadataEntry
+	(*structpb.Struct)(nil), // 2: google.protobuf.Struct
 }
 var file_zeproto_v1_error_proto_depIdxs = []int32{
-	1, // 0: zederr.v1.Error.arguments:type_name -> google.protobuf.Struct
+	2, // 0: zederr.v1.Error.arguments:type_name -> google.protobuf.Struct
 	0, // 1: zederr.v1.Error.causes:type_name -> zederr.v1.Error
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1, // 2: zederr.v1.Error.metadata:type_name -> zederr.v1.Error.MetadataEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_zeproto_v1_error_proto_init() }
@@ -186,7 +215,7 @@ func file_zeproto_v1_error_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_zeproto_v1_error_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},